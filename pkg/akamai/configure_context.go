@@ -56,7 +56,18 @@ func configureContext(cfg contextConfig) (*meta.OperationMeta, error) {
 	}
 	cache.Enable(cfg.enableCache)
 
-	return meta.New(sess, log.HCLog(), operationID)
+	opMeta, err := meta.New(sess, log.HCLog(), operationID)
+	if err != nil {
+		return nil, err
+	}
+	opMeta.SetRetryConfig(meta.RetryConfig{
+		Disabled: cfg.retryDisabled,
+		Max:      cfg.retryMax,
+		WaitMin:  cfg.retryWaitMin,
+		WaitMax:  cfg.retryWaitMax,
+	})
+
+	return opMeta, nil
 }
 
 func sessionWithoutRetry(opts []session.Option) (session.Session, error) {
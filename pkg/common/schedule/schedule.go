@@ -0,0 +1,351 @@
+// Package schedule contains logic for deferring activations until a given time or a recurring maintenance window.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// NotBeforeAttr is the name of the attribute holding the earliest activation time
+	NotBeforeAttr = "not_before"
+	// WindowAttr is the name of the block describing the recurring maintenance window
+	WindowAttr = "window"
+	// NextEligibleTimeAttr is the name of the computed attribute holding the next time the activation may start
+	NextEligibleTimeAttr = "next_eligible_time"
+
+	// maxWindowDuration limits the window length, so that at most one occurrence of the window is open at a time
+	maxWindowDuration = 24 * time.Hour
+)
+
+var (
+	// ErrSchedule is returned when the schedule configuration is invalid
+	ErrSchedule = errors.New("invalid activation schedule")
+	// ErrScheduleTimeout is returned when the activation window does not open before the operation times out
+	ErrScheduleTimeout = errors.New("activation window cannot be met")
+
+	weekdays = map[string]time.Weekday{
+		"SUNDAY":    time.Sunday,
+		"MONDAY":    time.Monday,
+		"TUESDAY":   time.Tuesday,
+		"WEDNESDAY": time.Wednesday,
+		"THURSDAY":  time.Thursday,
+		"FRIDAY":    time.Friday,
+		"SATURDAY":  time.Saturday,
+	}
+)
+
+type (
+	// Schedule describes when an activation may start
+	Schedule struct {
+		// NotBefore is the earliest time the activation may start, ignored when zero
+		NotBefore time.Time
+		// Window is the recurring maintenance window the activation must start in, ignored when nil
+		Window *Window
+	}
+
+	// Window is a recurring maintenance window opening at a given time of the day on selected days of the week
+	Window struct {
+		// Days limits the window to the given days of the week, all days are allowed when empty
+		Days []time.Weekday
+		// Hour and Minute define when the window opens
+		Hour, Minute int
+		// Duration defines how long the window stays open
+		Duration time.Duration
+		// Location is the time zone in which the window is defined
+		Location *time.Location
+	}
+)
+
+// NotBeforeSchema returns the schema of the attribute holding the earliest activation time
+func NotBeforeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateRFC3339,
+		Description:      "The earliest time the activation may start, in RFC3339 format",
+	}
+}
+
+// WindowSchema returns the schema of the block describing the recurring maintenance window
+func WindowSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The recurring maintenance window the activation must start in",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"days": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateWeekday},
+					Description: "Days of the week the window opens on, for example `SATURDAY`. The window opens every day when not set",
+				},
+				"start": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateTimeOfDay,
+					Description:      "Time of the day the window opens at, in HH:MM format",
+				},
+				"duration": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateWindowDuration,
+					Description:      "How long the window stays open, for example `2h30m`. At most 24 hours",
+				},
+				"time_zone": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "UTC",
+					ValidateDiagFunc: validateTimeZone,
+					Description:      "IANA time zone the window is defined in, for example `Europe/Warsaw`",
+				},
+			},
+		},
+	}
+}
+
+// NextEligibleTimeSchema returns the schema of the computed attribute holding the next time the activation may start
+func NextEligibleTimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The next time the activation may start according to `not_before` and `window`, in RFC3339 format",
+	}
+}
+
+// FromResourceData reads the schedule from the resource attributes. It returns nil when no schedule is configured.
+func FromResourceData(d tf.ResourceDataFetcher) (*Schedule, error) {
+	var s Schedule
+	if v, ok := d.GetOk(NotBeforeAttr); ok {
+		notBefore, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrSchedule, err)
+		}
+		s.NotBefore = notBefore
+	}
+
+	if v, ok := d.GetOk(WindowAttr); ok {
+		list := v.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			window, err := windowFromMap(list[0].(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			s.Window = window
+		}
+	}
+
+	if s.NotBefore.IsZero() && s.Window == nil {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func windowFromMap(m map[string]interface{}) (*Window, error) {
+	var w Window
+	start, err := time.Parse("15:04", m["start"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("%w: window start: %s", ErrSchedule, err)
+	}
+	w.Hour, w.Minute = start.Hour(), start.Minute()
+
+	if w.Duration, err = time.ParseDuration(m["duration"].(string)); err != nil {
+		return nil, fmt.Errorf("%w: window duration: %s", ErrSchedule, err)
+	}
+	if w.Duration <= 0 || w.Duration > maxWindowDuration {
+		return nil, fmt.Errorf("%w: window duration must be greater than 0 and at most %s", ErrSchedule, maxWindowDuration)
+	}
+
+	timeZone, _ := m["time_zone"].(string)
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	if w.Location, err = time.LoadLocation(timeZone); err != nil {
+		return nil, fmt.Errorf("%w: window time zone: %s", ErrSchedule, err)
+	}
+
+	if days, ok := m["days"].(*schema.Set); ok {
+		for _, day := range tf.SetToStringSlice(days) {
+			weekday, ok := weekdays[strings.ToUpper(day)]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown day of the week %q", ErrSchedule, day)
+			}
+			w.Days = append(w.Days, weekday)
+		}
+	}
+
+	return &w, nil
+}
+
+// Next returns the start of the first eligible period which has not ended before now.
+// A result which is not after now means the activation may start immediately.
+func (s *Schedule) Next(now time.Time) time.Time {
+	if s.Window == nil {
+		return s.NotBefore
+	}
+
+	earliest := now
+	if s.NotBefore.After(earliest) {
+		earliest = s.NotBefore
+	}
+
+	// a window opened the day before may still be open, and a week ahead always covers the next allowed day
+	local := earliest.In(s.Window.Location)
+	for offset := -1; offset <= 7; offset++ {
+		day := local.AddDate(0, 0, offset)
+		if !s.Window.allows(day.Weekday()) {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), s.Window.Hour, s.Window.Minute, 0, 0, s.Window.Location)
+		if !start.Add(s.Window.Duration).After(earliest) {
+			continue
+		}
+		if s.NotBefore.After(start) {
+			return s.NotBefore
+		}
+		return start
+	}
+
+	// not reachable with a valid window, as every allowed day occurs within a week
+	return time.Time{}
+}
+
+func (w *Window) allows(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error when the activation cannot start within the given timeout from now
+func (s *Schedule) Validate(now time.Time, timeout time.Duration) error {
+	next := s.Next(now)
+	if next.IsZero() {
+		return fmt.Errorf("%w: window never opens", ErrSchedule)
+	}
+	if next.Sub(now) > timeout {
+		return fmt.Errorf("%w: the activation may start at %s, which is after the timeout of %s", ErrScheduleTimeout,
+			next.Format(time.RFC3339), timeout)
+	}
+	return nil
+}
+
+// Wait blocks until the activation may start. It fails immediately when the context deadline comes before that time.
+func (s *Schedule) Wait(ctx context.Context) error {
+	for {
+		now := time.Now()
+		next := s.Next(now)
+		if !next.After(now) {
+			return nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(next) {
+			return fmt.Errorf("%w: the activation may start at %s, which is after the operation deadline %s", ErrScheduleTimeout,
+				next.Format(time.RFC3339), deadline.Format(time.RFC3339))
+		}
+
+		select {
+		case <-time.After(next.Sub(now)):
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for activation window: %w", ctx.Err())
+		}
+	}
+}
+
+// SetNextEligibleTime plans the next eligible time of the activation. It is known after apply only when the schedule
+// is not known yet. The plan fails when the activation cannot start before the timeout.
+func SetNextEligibleTime(d *schema.ResourceDiff, timeout time.Duration) error {
+	if !d.NewValueKnown(NotBeforeAttr) || !d.NewValueKnown(WindowAttr) {
+		return d.SetNewComputed(NextEligibleTimeAttr)
+	}
+	s, err := FromResourceData(d)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		if d.Get(NextEligibleTimeAttr).(string) != "" {
+			return d.SetNew(NextEligibleTimeAttr, "")
+		}
+		return nil
+	}
+
+	now := time.Now()
+	if err := s.Validate(now, timeout); err != nil {
+		return err
+	}
+	return d.SetNew(NextEligibleTimeAttr, s.Next(now).UTC().Format(time.RFC3339))
+}
+
+func validateRFC3339(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		return diag.Errorf("expected a time in RFC3339 format: %s", err)
+	}
+	return nil
+}
+
+func validateWeekday(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, ok := weekdays[strings.ToUpper(v.(string))]; !ok {
+		return diag.Errorf("expected a day of the week, for example MONDAY, got %q", v)
+	}
+	return nil
+}
+
+func validateTimeOfDay(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := time.Parse("15:04", v.(string)); err != nil {
+		return diag.Errorf("expected a time of the day in HH:MM format, got %q", v)
+	}
+	return nil
+}
+
+func validateWindowDuration(v interface{}, _ cty.Path) diag.Diagnostics {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.Errorf("provided incorrect duration: %s", err)
+	}
+	if duration <= 0 || duration > maxWindowDuration {
+		return diag.Errorf("window duration must be greater than 0 and at most %s", maxWindowDuration)
+	}
+	return nil
+}
+
+func validateTimeZone(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		return diag.Errorf("unknown time zone %q: %s", v, err)
+	}
+	return nil
+}
+
+// WaitFor waits until the activation configured in the resource data may start and records the time in the state
+// unless it was planned.
+// It fails immediately when the activation cannot start before the timeout of the operation.
+func WaitFor(ctx context.Context, d *schema.ResourceData, timeout time.Duration) error {
+	s, err := FromResourceData(d)
+	if err != nil || s == nil {
+		return err
+	}
+	now := time.Now()
+	if err := s.Validate(now, timeout); err != nil {
+		return err
+	}
+	// a time planned from a known schedule is kept, as the applied value must match the plan
+	if d.Get(NextEligibleTimeAttr).(string) == "" {
+		if err := d.Set(NextEligibleTimeAttr, s.Next(now).UTC().Format(time.RFC3339)); err != nil {
+			return fmt.Errorf("%w: %s", tf.ErrValueSet, err)
+		}
+	}
+	return s.Wait(ctx)
+}
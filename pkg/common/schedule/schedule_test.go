@@ -0,0 +1,189 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestNext(t *testing.T) {
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	require.NoError(t, err)
+	saturdayNight := &Window{Days: []time.Weekday{time.Saturday}, Hour: 23, Minute: 30, Duration: 2 * time.Hour, Location: time.UTC}
+
+	tests := map[string]struct {
+		schedule Schedule
+		now      string
+		expected string
+	}{
+		"not before in the future": {
+			schedule: Schedule{NotBefore: mustParse(t, "2024-06-01T10:00:00Z")},
+			now:      "2024-05-31T10:00:00Z",
+			expected: "2024-06-01T10:00:00Z",
+		},
+		"not before in the past": {
+			schedule: Schedule{NotBefore: mustParse(t, "2024-06-01T10:00:00Z")},
+			now:      "2024-06-02T10:00:00Z",
+			expected: "2024-06-01T10:00:00Z",
+		},
+		"before the window opens": {
+			// 2024-06-01 is a Saturday
+			schedule: Schedule{Window: saturdayNight},
+			now:      "2024-05-29T12:00:00Z",
+			expected: "2024-06-01T23:30:00Z",
+		},
+		"inside the window after midnight": {
+			schedule: Schedule{Window: saturdayNight},
+			now:      "2024-06-02T01:00:00Z",
+			expected: "2024-06-01T23:30:00Z",
+		},
+		"after the window closed": {
+			schedule: Schedule{Window: saturdayNight},
+			now:      "2024-06-02T01:30:00Z",
+			expected: "2024-06-08T23:30:00Z",
+		},
+		"not before inside the window": {
+			schedule: Schedule{NotBefore: mustParse(t, "2024-06-02T00:15:00Z"), Window: saturdayNight},
+			now:      "2024-05-29T12:00:00Z",
+			expected: "2024-06-02T00:15:00Z",
+		},
+		"not before after the window": {
+			schedule: Schedule{NotBefore: mustParse(t, "2024-06-02T02:00:00Z"), Window: saturdayNight},
+			now:      "2024-05-29T12:00:00Z",
+			expected: "2024-06-08T23:30:00Z",
+		},
+		"daily window in another time zone": {
+			schedule: Schedule{Window: &Window{Hour: 2, Duration: time.Hour, Location: warsaw}},
+			now:      "2024-06-01T10:00:00Z",
+			expected: "2024-06-02T00:00:00Z",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := test.schedule.Next(mustParse(t, test.now))
+			assert.Equal(t, test.expected, next.UTC().Format(time.RFC3339))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s := Schedule{Window: &Window{Days: []time.Weekday{time.Saturday}, Hour: 23, Duration: time.Hour, Location: time.UTC}}
+	now := mustParse(t, "2024-06-01T20:00:00Z")
+
+	assert.NoError(t, s.Validate(now, 4*time.Hour))
+	err := s.Validate(now, 90*time.Minute)
+	assert.True(t, errors.Is(err, ErrScheduleTimeout))
+	assert.Contains(t, err.Error(), "may start at 2024-06-01T23:00:00Z")
+}
+
+func TestWait(t *testing.T) {
+	t.Run("eligible now", func(t *testing.T) {
+		s := Schedule{NotBefore: time.Now().Add(-time.Minute)}
+		assert.NoError(t, s.Wait(context.Background()))
+	})
+
+	t.Run("waits until not before", func(t *testing.T) {
+		notBefore := time.Now().Add(50 * time.Millisecond)
+		s := Schedule{NotBefore: notBefore}
+		require.NoError(t, s.Wait(context.Background()))
+		assert.False(t, time.Now().Before(notBefore))
+	})
+
+	t.Run("deadline before window", func(t *testing.T) {
+		s := Schedule{NotBefore: time.Now().Add(time.Hour)}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		err := s.Wait(ctx)
+		assert.True(t, errors.Is(err, ErrScheduleTimeout))
+	})
+}
+
+func TestFromResourceData(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		NotBeforeAttr:        NotBeforeSchema(),
+		WindowAttr:           WindowSchema(),
+		NextEligibleTimeAttr: NextEligibleTimeSchema(),
+	}
+
+	tests := map[string]struct {
+		config   map[string]interface{}
+		expected *Schedule
+	}{
+		"no schedule": {
+			config: map[string]interface{}{},
+		},
+		"not before": {
+			config:   map[string]interface{}{"not_before": "2024-06-01T10:00:00Z"},
+			expected: &Schedule{NotBefore: mustParse(t, "2024-06-01T10:00:00Z")},
+		},
+		"window": {
+			config: map[string]interface{}{
+				"window": []interface{}{map[string]interface{}{
+					"days":     []interface{}{"saturday"},
+					"start":    "23:30",
+					"duration": "2h",
+				}},
+			},
+			expected: &Schedule{Window: &Window{
+				Days:     []time.Weekday{time.Saturday},
+				Hour:     23,
+				Minute:   30,
+				Duration: 2 * time.Hour,
+				Location: time.UTC,
+			}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, test.config)
+			s, err := FromResourceData(d)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, s)
+		})
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		NotBeforeAttr:        NotBeforeSchema(),
+		WindowAttr:           WindowSchema(),
+		NextEligibleTimeAttr: NextEligibleTimeSchema(),
+	}
+
+	t.Run("eligible now", func(t *testing.T) {
+		notBefore := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"not_before": notBefore})
+		require.NoError(t, WaitFor(context.Background(), d, time.Minute))
+		assert.Equal(t, notBefore, d.Get(NextEligibleTimeAttr))
+	})
+
+	t.Run("eligible after timeout", func(t *testing.T) {
+		notBefore := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"not_before": notBefore})
+		err := WaitFor(context.Background(), d, time.Minute)
+		assert.True(t, errors.Is(err, ErrScheduleTimeout))
+		assert.Empty(t, d.Get(NextEligibleTimeAttr))
+	})
+
+	t.Run("planned time is kept", func(t *testing.T) {
+		notBefore := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		planned := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"not_before": notBefore})
+		require.NoError(t, d.Set(NextEligibleTimeAttr, planned))
+		require.NoError(t, WaitFor(context.Background(), d, time.Minute))
+		assert.Equal(t, planned, d.Get(NextEligibleTimeAttr))
+	})
+}
@@ -4,6 +4,7 @@ package meta
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/logger"
@@ -24,6 +25,9 @@ type (
 
 		// Session returns the operation API session
 		Session() session.Session
+
+		// RetryConfig returns the retry settings of the provider
+		RetryConfig() RetryConfig
 	}
 
 	// OperationMeta is the implementation of Meta interface
@@ -31,6 +35,15 @@ type (
 		operationID string
 		log         hclog.Logger
 		sess        session.Session
+		retry       RetryConfig
+	}
+
+	// RetryConfig contains the retry settings of the provider. Zero values mean the setting was not configured
+	RetryConfig struct {
+		Disabled bool
+		Max      int
+		WaitMin  time.Duration
+		WaitMax  time.Duration
 	}
 )
 
@@ -78,3 +91,13 @@ func (m *OperationMeta) OperationID() string {
 func (m *OperationMeta) Session() session.Session {
 	return m.sess
 }
+
+// RetryConfig returns the retry settings of the provider from the meta
+func (m *OperationMeta) RetryConfig() RetryConfig {
+	return m.retry
+}
+
+// SetRetryConfig sets the retry settings of the provider in the meta
+func (m *OperationMeta) SetRetryConfig(cfg RetryConfig) {
+	m.retry = cfg
+}
@@ -2,6 +2,7 @@ package meta
 
 import (
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
 	"github.com/hashicorp/go-hclog"
@@ -23,6 +24,11 @@ func TestMeta(t *testing.T) {
 	t.Run("OperationID() return operationID", func(t *testing.T) {
 		assert.Equal(t, operationID, meta.OperationID())
 	})
+	t.Run("RetryConfig() return retry settings", func(t *testing.T) {
+		assert.Equal(t, RetryConfig{}, meta.RetryConfig())
+		meta.SetRetryConfig(RetryConfig{Max: 3, WaitMin: time.Second})
+		assert.Equal(t, RetryConfig{Max: 3, WaitMin: time.Second}, meta.RetryConfig())
+	})
 }
 
 func TestNew_err(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/schedule"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
 	"github.com/hashicorp/go-hclog"
@@ -27,6 +28,7 @@ func resourceActivations() *schema.Resource {
 		DeleteContext: resourceActivationsDelete,
 		CustomizeDiff: customdiff.All(
 			VerifyIDUnchanged,
+			setActivationNextEligibleTime,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImporter,
@@ -66,6 +68,9 @@ func resourceActivations() *schema.Resource {
 				Computed:    true,
 				Description: "The results of the activation",
 			},
+			"not_before":         schedule.NotBeforeSchema(),
+			"window":             schedule.WindowSchema(),
+			"next_eligible_time": schedule.NextEligibleTimeSchema(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Default: &AppsecResourceTimeout,
@@ -135,6 +140,10 @@ func resourceActivationsCreate(ctx context.Context, d *schema.ResourceData, m in
 		ConfigVersion: version,
 	})
 
+	if err := schedule.WaitFor(ctx, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	activationResp, err := createActivation(ctx, client, createActivationRequest)
 	if err != nil {
 		return diag.FromErr(err)
@@ -206,6 +215,11 @@ func resourceActivationsUpdate(ctx context.Context, d *schema.ResourceData, m in
 	logger := meta.Log("APPSEC", "resourceActivationsUpdate")
 	logger.Debug("in resourceActivationsUpdate")
 
+	if !d.HasChangesExcept("not_before", "window", "next_eligible_time") {
+		logger.Debug("Only schedule was updated, update with no API calls")
+		return nil
+	}
+
 	configID, err := tf.GetIntValue("config_id", d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -246,6 +260,10 @@ func resourceActivationsUpdate(ctx context.Context, d *schema.ResourceData, m in
 		ConfigVersion: version,
 	})
 
+	if err := schedule.WaitFor(ctx, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	activationResp, err := createActivation(ctx, client, createActivationRequest)
 	if err != nil {
 		return diag.FromErr(err)
@@ -272,6 +290,37 @@ func resourceActivationsUpdate(ctx context.Context, d *schema.ResourceData, m in
 	return resourceActivationsRead(ctx, d, m)
 }
 
+// setActivationNextEligibleTime plans the next eligible time of a pending activation
+// and fails the plan when the activation cannot start before the timeout
+func setActivationNextEligibleTime(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChanges("config_id", "version", "network") {
+		return nil
+	}
+	timeout, err := plannedTimeout(d)
+	if err != nil {
+		return err
+	}
+	return schedule.SetNextEligibleTime(d, timeout)
+}
+
+// plannedTimeout returns the timeout of the resource operations. Timeouts are not available from the diff,
+// so the configured one is read from the raw configuration.
+func plannedTimeout(d *schema.ResourceDiff) (time.Duration, error) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return AppsecResourceTimeout, nil
+	}
+	timeouts := config.GetAttr(schema.TimeoutsConfigKey)
+	if timeouts.IsNull() || !timeouts.IsKnown() {
+		return AppsecResourceTimeout, nil
+	}
+	timeout := timeouts.GetAttr(schema.TimeoutDefault)
+	if timeout.IsNull() || !timeout.IsKnown() {
+		return AppsecResourceTimeout, nil
+	}
+	return time.ParseDuration(timeout.AsString())
+}
+
 func resourceActivationsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := meta.Must(m)
 	client := inst.Client(meta)
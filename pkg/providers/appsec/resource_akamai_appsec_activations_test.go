@@ -821,4 +821,23 @@ func TestAkamaiActivations_res_basic(t *testing.T) {
 
 	})
 
+	t.Run("schedule after the configured timeout fails the plan", func(t *testing.T) {
+		client := &appsec.Mock{}
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResActivations/schedule_after_timeout.tf"),
+						ExpectError: regexp.MustCompile("the activation may start at 2099-01-01T00:00:00Z, which is after the timeout of 2h0m0s"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
 }
@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc        = "../../common/testutils/edgerc"
+  cache_enabled = false
+}
+
+resource "akamai_appsec_activations" "test" {
+  config_id           = 43253
+  version             = 7
+  network             = "STAGING"
+  note                = "Test Notes"
+  notification_emails = ["user@example.com"]
+  not_before          = "2099-01-01T00:00:00Z"
+
+  timeouts {
+    default = "2h"
+  }
+}
@@ -0,0 +1,296 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/hash"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// changeLists covers the changelist operations of the Edge DNS API which are not available in the edgegrid client yet.
+// Edge DNS keeps a single changelist per zone, so changelists are identified by the zone name.
+type changeLists interface {
+	// AddChange stages a recordset change in the changelist of the zone
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-recordsets-add-change
+	AddChange(context.Context, string, changeListChange) error
+
+	// GetDiff returns the recordsets changed by the changelist of the zone
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/get-changelists-zone-diff
+	GetDiff(context.Context, string) (*changeListDiff, error)
+
+	// DeleteChangeList discards the changelist of the zone
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/delete-changelists-zone
+	DeleteChangeList(context.Context, string) error
+}
+
+type (
+	changeListsClient struct {
+		session session.Session
+	}
+
+	// changeListChange is a recordset change staged in a changelist
+	changeListChange struct {
+		Name  string   `json:"name"`
+		Type  string   `json:"type"`
+		Op    string   `json:"op"`
+		TTL   int      `json:"ttl,omitempty"`
+		Rdata []string `json:"rdata,omitempty"`
+	}
+
+	// changeListDiff lists recordsets added, updated and deleted by a changelist
+	changeListDiff struct {
+		Zone      string          `json:"zone"`
+		ChangeTag string          `json:"changeTag"`
+		Adds      []dns.RecordSet `json:"adds"`
+		Updates   []dns.RecordSet `json:"updates"`
+		Deletes   []dns.RecordSet `json:"deletes"`
+	}
+)
+
+// Changelist change operations
+const (
+	changeListOpAdd    = "ADD"
+	changeListOpEdit   = "EDIT"
+	changeListOpDelete = "DELETE"
+)
+
+var (
+	// ErrAddChangeListChange is returned when staging a change in a changelist fails
+	ErrAddChangeListChange = errors.New("adding change to changelist")
+	// ErrGetChangeListDiff is returned when fetching the changelist diff fails
+	ErrGetChangeListDiff = errors.New("fetching changelist diff")
+	// ErrDeleteChangeList is returned when discarding a changelist fails
+	ErrDeleteChangeList = errors.New("deleting changelist")
+	// ErrStaleChangeList is returned when the zone was modified after its changelist was created
+	ErrStaleChangeList = errors.New("the zone was modified after the changelist was created")
+)
+
+func (c *changeListsClient) AddChange(ctx context.Context, zone string, change changeListChange) error {
+	uri := fmt.Sprintf("/config-dns/v2/changelists/%s/recordsets/add-change", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrAddChangeListChange, err)
+	}
+
+	resp, err := c.session.Exec(req, nil, change)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrAddChangeListChange, err)
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %w", ErrAddChangeListChange, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *changeListsClient) GetDiff(ctx context.Context, zone string) (*changeListDiff, error) {
+	uri := fmt.Sprintf("/config-dns/v2/changelists/%s/diff", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetChangeListDiff, err)
+	}
+
+	var result changeListDiff
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetChangeListDiff, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrGetChangeListDiff, responseError(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *changeListsClient) DeleteChangeList(ctx context.Context, zone string) error {
+	uri := fmt.Sprintf("/config-dns/v2/changelists/%s", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteChangeList, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteChangeList, err)
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %w", ErrDeleteChangeList, responseError(resp))
+	}
+
+	return nil
+}
+
+// responseError returns the API error of the response in the format of the edgegrid client
+func responseError(resp *http.Response) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading error response body: %s", err)
+	}
+
+	var apiErr dns.Error
+	if err := json.Unmarshal(data, &apiErr); err != nil {
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, string(data))
+	}
+	apiErr.StatusCode = resp.StatusCode
+
+	return &apiErr
+}
+
+// isNotFound reports whether err is an Edge DNS API error with the 404 status
+func isNotFound(err error) bool {
+	var apiError *dns.Error
+	return errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound
+}
+
+// stageChange adds the change to the changelist of the zone, creating the changelist first when there is none
+func stageChange(ctx context.Context, client dns.DNS, changeLists changeLists, zone string, change changeListChange) error {
+	lock := zoneLock(zone)
+	lock.Lock()
+	defer lock.Unlock()
+
+	changeList, err := client.GetChangeList(ctx, dns.GetChangeListRequest{Zone: zone})
+	switch {
+	case isNotFound(err):
+		zoneResp, err := client.GetZone(ctx, dns.GetZoneRequest{Zone: zone})
+		if err != nil {
+			return fmt.Errorf("failed to read zone %s: %w", zone, err)
+		}
+		if err := client.SaveChangeList(ctx, dns.SaveChangeListRequest{Zone: zone, Type: zoneResp.Type}); err != nil {
+			return fmt.Errorf("failed to create changelist for zone %s: %w", zone, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to read changelist for zone %s: %w", zone, err)
+	case changeList.Stale:
+		return fmt.Errorf("%w: changelist for zone %s", ErrStaleChangeList, zone)
+	}
+
+	if err := changeLists.AddChange(ctx, zone, change); err != nil {
+		return fmt.Errorf("failed to stage %s of %s %s in changelist for zone %s: %w", change.Op, change.Name, change.Type, zone, err)
+	}
+	return nil
+}
+
+// stageRecordChange stages the record of the resource data, or its removal, in the changelist of the zone
+func stageRecordChange(ctx context.Context, meta meta.Meta, d *schema.ResourceData, remove bool) error {
+	logger := meta.Log("AkamaiDNS", "stageRecordChange")
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return err
+	}
+	host, err := tf.GetStringValue("name", d)
+	if err != nil {
+		return err
+	}
+	recordType, err := tf.GetStringValue("recordtype", d)
+	if err != nil {
+		return err
+	}
+	if recordType == RRTypeSoa {
+		return fmt.Errorf("%s record of zone %s cannot be staged in a changelist", recordType, zone)
+	}
+
+	change := changeListChange{Name: host, Type: recordType, Op: changeListOpDelete}
+	if !remove {
+		record, err := bindRecord(ctx, meta, d, logger)
+		if err != nil {
+			return err
+		}
+		change.TTL, change.Rdata = record.TTL, record.Target
+
+		change.Op = changeListOpEdit
+		_, err = inst.Client(meta).GetRecord(ctx, dns.GetRecordRequest{Zone: zone, Name: host, RecordType: recordType})
+		switch {
+		case isNotFound(err):
+			change.Op = changeListOpAdd
+		case err != nil:
+			return fmt.Errorf("failed looking up %s records for %s: %w", recordType, host, err)
+		}
+	}
+
+	logger.WithFields(log.Fields{
+		"zone":       zone,
+		"host":       host,
+		"recordtype": recordType,
+		"op":         change.Op,
+	}).Info("Staging record change")
+
+	return stageChange(ctx, inst.Client(meta), changeListsClientFor(meta), zone, change)
+}
+
+// recordChangePending reports whether the record is managed through a changelist of its zone and a change of it
+// is staged in that changelist but not submitted yet
+func recordChangePending(ctx context.Context, meta meta.Meta, d *schema.ResourceData, zone string) (bool, error) {
+	if !d.Get("use_changelist").(bool) {
+		return false, nil
+	}
+	host, err := tf.GetStringValue("name", d)
+	if err != nil {
+		return false, err
+	}
+	recordType, err := tf.GetStringValue("recordtype", d)
+	if err != nil {
+		return false, err
+	}
+	return changeListHasRecord(ctx, changeListsClientFor(meta), zone, host, recordType)
+}
+
+// changeListHasRecord reports whether the changelist of the zone adds, updates or deletes the given recordset
+func changeListHasRecord(ctx context.Context, changeLists changeLists, zone, host, recordType string) (bool, error) {
+	diff, err := changeLists.GetDiff(ctx, zone)
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read changelist for zone %s: %w", zone, err)
+	}
+
+	for _, recordSets := range [][]dns.RecordSet{diff.Adds, diff.Updates, diff.Deletes} {
+		for _, recordSet := range recordSets {
+			if strings.EqualFold(strings.TrimSuffix(recordSet.Name, "."), strings.TrimSuffix(host, ".")) &&
+				strings.EqualFold(recordSet.Type, recordType) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// resourceDNSRecordStage creates or updates the record in the changelist of its zone
+func resourceDNSRecordStage(ctx context.Context, d *schema.ResourceData, meta meta.Meta, logger log.Interface) diag.Diagnostics {
+	if err := stageRecordChange(ctx, meta, d, false); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Recordset changelist staging failure",
+			Detail:   err.Error(),
+		}}
+	}
+
+	record, err := bindRecord(ctx, meta, d, logger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("record_sha", hash.GetSHAString(strings.Join(record.Target, " "))); err != nil {
+		return diag.Errorf("%v: %s", tf.ErrValueSet, err.Error())
+	}
+	if d.Id() == "" {
+		d.SetId(fmt.Sprintf("%s#%s#%s", d.Get("zone").(string), record.Name, record.RecordType))
+	}
+
+	return resourceDNSRecordRead(ctx, d, meta)
+}
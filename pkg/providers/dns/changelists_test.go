@@ -0,0 +1,221 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockChangeLists struct {
+	mock.Mock
+}
+
+func (m *mockChangeLists) AddChange(ctx context.Context, zone string, change changeListChange) error {
+	args := m.Called(ctx, zone, change)
+	return args.Error(0)
+}
+
+func (m *mockChangeLists) GetDiff(ctx context.Context, zone string) (*changeListDiff, error) {
+	args := m.Called(ctx, zone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*changeListDiff), args.Error(1)
+}
+
+func (m *mockChangeLists) DeleteChangeList(ctx context.Context, zone string) error {
+	args := m.Called(ctx, zone)
+	return args.Error(0)
+}
+
+func mockSession(t *testing.T, mockServer *httptest.Server) session.Session {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return s
+}
+
+func TestChangeListsClient(t *testing.T) {
+	t.Run("add change", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/config-dns/v2/changelists/example.com/recordsets/add-change", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, map[string]interface{}{
+				"name":  "www.example.com",
+				"type":  "CNAME",
+				"op":    "EDIT",
+				"ttl":   float64(300),
+				"rdata": []interface{}{"origin.example.net."},
+			}, got)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer mockServer.Close()
+
+		client := &changeListsClient{session: mockSession(t, mockServer)}
+		err := client.AddChange(context.Background(), "example.com", changeListChange{
+			Name:  "www.example.com",
+			Type:  "CNAME",
+			Op:    changeListOpEdit,
+			TTL:   300,
+			Rdata: []string{"origin.example.net."},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("get diff", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/config-dns/v2/changelists/example.com/diff", r.URL.Path)
+			_, err := w.Write([]byte(`{"zone":"example.com","changeTag":"tag-1","adds":[{"name":"_verify.example.com","type":"TXT","ttl":300,"rdata":["\"token\""]}],"updates":[],"deletes":[]}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		client := &changeListsClient{session: mockSession(t, mockServer)}
+		diff, err := client.GetDiff(context.Background(), "example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "tag-1", diff.ChangeTag)
+		assert.Equal(t, []dns.RecordSet{{Name: "_verify.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"token"`}}}, diff.Adds)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"title":"Not Found","detail":"Changelist for zone example.com not found"}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		client := &changeListsClient{session: mockSession(t, mockServer)}
+		err := client.DeleteChangeList(context.Background(), "example.com")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrDeleteChangeList))
+		assert.True(t, isNotFound(err))
+	})
+}
+
+func TestStageChange(t *testing.T) {
+	change := changeListChange{Name: "www.example.com", Type: "A", Op: changeListOpAdd, TTL: 300, Rdata: []string{"192.0.2.1"}}
+	notFound := &dns.Error{StatusCode: http.StatusNotFound}
+
+	tests := map[string]struct {
+		init      func(*dns.Mock, *mockChangeLists)
+		withError error
+	}{
+		"changelist is created": {
+			init: func(client *dns.Mock, changeLists *mockChangeLists) {
+				client.On("GetChangeList", mock.Anything, dns.GetChangeListRequest{Zone: "example.com"}).Return(nil, notFound).Once()
+				client.On("GetZone", mock.Anything, dns.GetZoneRequest{Zone: "example.com"}).Return(&dns.GetZoneResponse{Zone: "example.com", Type: "PRIMARY"}, nil).Once()
+				client.On("SaveChangeList", mock.Anything, dns.SaveChangeListRequest{Zone: "example.com", Type: "PRIMARY"}).Return(nil).Once()
+				changeLists.On("AddChange", mock.Anything, "example.com", change).Return(nil).Once()
+			},
+		},
+		"existing changelist is used": {
+			init: func(client *dns.Mock, changeLists *mockChangeLists) {
+				client.On("GetChangeList", mock.Anything, dns.GetChangeListRequest{Zone: "example.com"}).Return(&dns.GetChangeListResponse{Zone: "example.com"}, nil).Once()
+				changeLists.On("AddChange", mock.Anything, "example.com", change).Return(nil).Once()
+			},
+		},
+		"stale changelist": {
+			init: func(client *dns.Mock, _ *mockChangeLists) {
+				client.On("GetChangeList", mock.Anything, dns.GetChangeListRequest{Zone: "example.com"}).Return(&dns.GetChangeListResponse{Zone: "example.com", Stale: true}, nil).Once()
+			},
+			withError: ErrStaleChangeList,
+		},
+		"adding change fails": {
+			init: func(client *dns.Mock, changeLists *mockChangeLists) {
+				client.On("GetChangeList", mock.Anything, dns.GetChangeListRequest{Zone: "example.com"}).Return(&dns.GetChangeListResponse{Zone: "example.com"}, nil).Once()
+				changeLists.On("AddChange", mock.Anything, "example.com", change).Return(ErrAddChangeListChange).Once()
+			},
+			withError: ErrAddChangeListChange,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &dns.Mock{}
+			changeLists := &mockChangeLists{}
+			test.init(client, changeLists)
+
+			err := stageChange(context.Background(), client, changeLists, "example.com", change)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			client.AssertExpectations(t)
+			changeLists.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChangeListHasRecord(t *testing.T) {
+	diff := &changeListDiff{
+		Zone:    "example.com",
+		Adds:    []dns.RecordSet{{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}}},
+		Deletes: []dns.RecordSet{{Name: "old.example.com.", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}}},
+	}
+
+	tests := map[string]struct {
+		host, recordType string
+		diff             *changeListDiff
+		err              error
+		expected         bool
+		withError        bool
+	}{
+		"record is added":              {host: "www.example.com", recordType: "A", diff: diff, expected: true},
+		"record is deleted":            {host: "old.example.com", recordType: "CNAME", diff: diff, expected: true},
+		"other type of the same name":  {host: "www.example.com", recordType: "AAAA", diff: diff},
+		"other record of the zone":     {host: "api.example.com", recordType: "A", diff: diff},
+		"no changelist":                {host: "www.example.com", recordType: "A", err: &dns.Error{StatusCode: http.StatusNotFound}},
+		"changelist cannot be fetched": {host: "www.example.com", recordType: "A", err: ErrGetChangeListDiff, withError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			changeLists := &mockChangeLists{}
+			if test.err != nil {
+				changeLists.On("GetDiff", mock.Anything, "example.com").Return(nil, test.err).Once()
+			} else {
+				changeLists.On("GetDiff", mock.Anything, "example.com").Return(test.diff, nil).Once()
+			}
+
+			pending, err := changeListHasRecord(context.Background(), changeLists, "example.com", test.host, test.recordType)
+			if test.withError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, pending)
+			changeLists.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDNSRecordPropagation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordPropagationRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: &propagationTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone whose authoritative nameservers are queried",
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the record",
+			},
+			"recordtype": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(propagationRecordTypeNames(), false)),
+				Description:      "Type of the record",
+			},
+			"expected_rdata": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Rdata the nameservers are expected to return, in the format of the target of akamai_dns_record",
+			},
+			"resolvers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Nameservers, as 'host' or 'host:port', queried instead of the authorities of the zone",
+			},
+			"nameservers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Nameservers which returned the expected rdata",
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordPropagationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "dataSourceDNSRecordPropagationRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	name, err := tf.GetStringValue("name", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	recordType, err := tf.GetStringValue("recordtype", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	expected, err := tf.GetListValue("expected_rdata", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nameservers, err := propagationNameservers(ctx, inst.Client(meta), d, "resolvers", zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rdata := make([]string, 0, len(expected))
+	for _, value := range expected {
+		rdata = append(rdata, value.(string))
+	}
+
+	logger.WithFields(log.Fields{
+		"name":        name,
+		"recordtype":  recordType,
+		"nameservers": nameservers,
+	}).Info("Waiting for record propagation")
+	if err := waitForPropagation(ctx, nameservers, name, recordType, rdata, logger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("nameservers", nameservers); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
+	d.SetId(fmt.Sprintf("%s#%s#%s", zone, name, recordType))
+	return nil
+}
@@ -0,0 +1,91 @@
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestDataDNSRecordPropagation(t *testing.T) {
+	propagationPollMinimum, propagationPollInterval = time.Millisecond, time.Millisecond
+	dataSourceName := "data.akamai_dns_record_propagation.test"
+
+	t.Run("resolvers", func(t *testing.T) {
+		client := &dns.Mock{}
+		ns := newTestNameserver(t)
+		ns.serve("www.example.com.", dnsmessage.TypeA, &dnsmessage.AResource{A: netip.MustParseAddr("192.0.2.1").As4()})
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						// the fixture lists the address of the local nameserver as the resolver
+						Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestDataDnsRecordPropagation/resolvers.tf"), ns.addr),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "id", "example.com#www.example.com#A"),
+							resource.TestCheckResourceAttr(dataSourceName, "nameservers.#", "1"),
+							resource.TestCheckResourceAttr(dataSourceName, "nameservers.0", ns.addr),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("authorities of the zone", func(t *testing.T) {
+		client := &dns.Mock{}
+		ns := newTestNameserver(t)
+		ns.serve("www.example.com.", dnsmessage.TypeA, &dnsmessage.AResource{A: netip.MustParseAddr("192.0.2.1").As4()})
+
+		client.On("GetZone", mock.Anything, dns.GetZoneRequest{Zone: "example.com"}).
+			Return(&dns.GetZoneResponse{Zone: "example.com", ContractID: "1-ABCD"}, nil)
+		client.On("GetNameServerRecordList", mock.Anything, dns.GetNameServerRecordListRequest{ContractIDs: "1-ABCD"}).
+			Return([]string{ns.addr}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestDataDnsRecordPropagation/authorities.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "nameservers.#", "1"),
+							resource.TestCheckResourceAttr(dataSourceName, "nameservers.0", ns.addr),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("unsupported record type", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestDataDnsRecordPropagation/unsupported_type.tf"),
+						ExpectError: regexp.MustCompile(`expected recordtype to be one of`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
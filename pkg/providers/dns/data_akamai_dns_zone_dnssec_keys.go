@@ -0,0 +1,251 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	zoneDNSSecKeysDataSource struct {
+		meta meta.Meta
+	}
+
+	zoneDNSSecKeysDataSourceModel struct {
+		Zone               types.String        `tfsdk:"zone"`
+		ExpectedTTL        types.Int64         `tfsdk:"expected_ttl"`
+		DSRecords          []dsRecordModel     `tfsdk:"ds_records"`
+		DNSKeyRecords      []dnskeyRecordModel `tfsdk:"dnskey_records"`
+		RolloverInProgress types.Bool          `tfsdk:"rollover_in_progress"`
+		NewDSRecords       []dsRecordModel     `tfsdk:"new_ds_records"`
+		NewDNSKeyRecords   []dnskeyRecordModel `tfsdk:"new_dnskey_records"`
+	}
+
+	dsRecordModel struct {
+		KeyTag     types.Int64  `tfsdk:"keytag"`
+		Algorithm  types.Int64  `tfsdk:"algorithm"`
+		DigestType types.Int64  `tfsdk:"digest_type"`
+		Digest     types.String `tfsdk:"digest"`
+	}
+
+	dnskeyRecordModel struct {
+		KeyTag    types.Int64  `tfsdk:"keytag"`
+		Flags     types.Int64  `tfsdk:"flags"`
+		Protocol  types.Int64  `tfsdk:"protocol"`
+		Algorithm types.Int64  `tfsdk:"algorithm"`
+		Key       types.String `tfsdk:"key"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = &zoneDNSSecKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &zoneDNSSecKeysDataSource{}
+)
+
+// NewZoneDNSSecKeysDataSource returns a new single zone's DNSSEC keys data source
+func NewZoneDNSSecKeysDataSource() datasource.DataSource { return &zoneDNSSecKeysDataSource{} }
+
+func (d *zoneDNSSecKeysDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "akamai_zone_dnssec_keys"
+}
+
+func (d *zoneDNSSecKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Data Source Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	d.meta = meta.Must(req.ProviderData)
+}
+
+func (d *zoneDNSSecKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Single zone's DNSSEC DS and DNSKEY records data source.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the zone.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expected_ttl": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The TTL on the NS record for this zone. This should match the TTL on the DS record in the parent zone.",
+			},
+			"ds_records": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "The DS records of the active keys, to be published in the parent zone.",
+				NestedObject: dsRecordSchema(),
+			},
+			"dnskey_records": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "The DNSKEY records of the active keys.",
+				NestedObject: dnskeyRecordSchema(),
+			},
+			"rollover_in_progress": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether new keys were generated and the rollover to them is not complete yet.",
+			},
+			"new_ds_records": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "The DS records of the new keys during a rollover.",
+				NestedObject: dsRecordSchema(),
+			},
+			"new_dnskey_records": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "The DNSKEY records of the new keys during a rollover.",
+				NestedObject: dnskeyRecordSchema(),
+			},
+		},
+	}
+}
+
+func dsRecordSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"keytag": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The key tag of the DNSKEY record the DS record refers to.",
+			},
+			"algorithm": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The algorithm of the key.",
+			},
+			"digest_type": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The algorithm used to construct the digest.",
+			},
+			"digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "The digest of the DNSKEY record in hexadecimal.",
+			},
+		},
+	}
+}
+
+func dnskeyRecordSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"keytag": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The key tag of the key.",
+			},
+			"flags": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The flags of the key, 257 for a key signing key.",
+			},
+			"protocol": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The protocol of the key.",
+			},
+			"algorithm": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The algorithm of the key.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key in base64.",
+			},
+		},
+	}
+}
+
+func (d *zoneDNSSecKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "DNS ZoneDNSSecKeys DataSource Read")
+
+	var data zoneDNSSecKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := inst.Client(d.meta)
+	zoneName := data.Zone.ValueString()
+	zonesDNSSecStatus, err := client.GetZonesDNSSecStatus(ctx, dns.GetZonesDNSSecStatusRequest{
+		Zones: []string{zoneName},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("fetching DNS ZoneDNSSecStatus failed: ", err.Error())
+		return
+	}
+	// No status object is returned by Edge DNS if the zone has DNSSEC disabled
+	if len(zonesDNSSecStatus.DNSSecStatuses) == 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("no DNSSEC status for zone: %s", zoneName),
+			"make sure that zone has DNSSEC enabled")
+		return
+	}
+
+	if err := data.setAttributes(zonesDNSSecStatus.DNSSecStatuses[0]); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("parsing DNSSEC records of zone %s failed", zoneName), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m *zoneDNSSecKeysDataSourceModel) setAttributes(secStatus dns.SecStatus) error {
+	var err error
+	m.ExpectedTTL = types.Int64Value(secStatus.CurrentRecords.ExpectedTTL)
+	if m.DSRecords, m.DNSKeyRecords, err = newKeyRecordModels(secStatus.CurrentRecords); err != nil {
+		return err
+	}
+	m.RolloverInProgress = types.BoolValue(secStatus.NewRecords != nil)
+	if secStatus.NewRecords != nil {
+		if m.NewDSRecords, m.NewDNSKeyRecords, err = newKeyRecordModels(*secStatus.NewRecords); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newKeyRecordModels(records dns.SecRecords) ([]dsRecordModel, []dnskeyRecordModel, error) {
+	dsRecords, err := parseDSRecords(records.DSRecord)
+	if err != nil {
+		return nil, nil, err
+	}
+	dnskeyRecords, err := parseDNSKeyRecords(records.DNSKeyRecord)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dsModels := make([]dsRecordModel, 0, len(dsRecords))
+	for _, ds := range dsRecords {
+		dsModels = append(dsModels, dsRecordModel{
+			KeyTag:     types.Int64Value(int64(ds.KeyTag)),
+			Algorithm:  types.Int64Value(int64(ds.Algorithm)),
+			DigestType: types.Int64Value(int64(ds.DigestType)),
+			Digest:     types.StringValue(ds.Digest),
+		})
+	}
+	dnskeyModels := make([]dnskeyRecordModel, 0, len(dnskeyRecords))
+	for _, dnskey := range dnskeyRecords {
+		keyTag, err := dnskey.KeyTag()
+		if err != nil {
+			return nil, nil, err
+		}
+		dnskeyModels = append(dnskeyModels, dnskeyRecordModel{
+			KeyTag:    types.Int64Value(int64(keyTag)),
+			Flags:     types.Int64Value(int64(dnskey.Flags)),
+			Protocol:  types.Int64Value(int64(dnskey.Protocol)),
+			Algorithm: types.Int64Value(int64(dnskey.Algorithm)),
+			Key:       types.StringValue(dnskey.Key),
+		})
+	}
+	return dsModels, dnskeyModels, nil
+}
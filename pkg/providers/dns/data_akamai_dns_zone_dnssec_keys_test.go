@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataZoneDnsSecKeys(t *testing.T) {
+	anyContext := mock.AnythingOfType("*context.valueCtx")
+	request := dns.GetZonesDNSSecStatusRequest{
+		Zones: []string{"test.zone.net"},
+	}
+
+	tests := map[string]struct {
+		init               func(mock *dns.Mock)
+		expectedAttributes map[string]string
+		expectedError      *regexp.Regexp
+	}{
+		"keys of signed zone": {
+			init: func(m *dns.Mock) {
+				m.On("GetZonesDNSSecStatus", anyContext, request).Return(
+					&dns.GetZonesDNSSecStatusResponse{
+						DNSSecStatuses: []dns.SecStatus{{
+							Zone: "test.zone.net",
+							CurrentRecords: dns.SecRecords{
+								DNSKeyRecord: testDNSKeyRecord,
+								DSRecord:     testDSRecord,
+								ExpectedTTL:  86400,
+							},
+						}},
+					}, nil)
+			},
+			expectedAttributes: map[string]string{
+				"expected_ttl":             "86400",
+				"ds_records.#":             "1",
+				"ds_records.0.keytag":      "60485",
+				"ds_records.0.algorithm":   "5",
+				"ds_records.0.digest_type": "1",
+				"ds_records.0.digest":      "2BB183AF5F22588179A53B0A98631FAD1A292118",
+				"dnskey_records.#":         "1",
+				"dnskey_records.0.keytag":  "60485",
+				"dnskey_records.0.flags":   "256",
+				"rollover_in_progress":     "false",
+				"new_ds_records.#":         "0",
+			},
+		},
+		"rollover in progress": {
+			init: func(m *dns.Mock) {
+				m.On("GetZonesDNSSecStatus", anyContext, request).Return(
+					&dns.GetZonesDNSSecStatusResponse{
+						DNSSecStatuses: []dns.SecStatus{{
+							Zone:           "test.zone.net",
+							CurrentRecords: dns.SecRecords{DNSKeyRecord: testDNSKeyRecord, DSRecord: testDSRecord},
+							NewRecords:     &dns.SecRecords{DNSKeyRecord: testDNSKeyRecord, DSRecord: "60485 5 2 D4B7D520"},
+						}},
+					}, nil)
+			},
+			expectedAttributes: map[string]string{
+				"rollover_in_progress":         "true",
+				"new_ds_records.#":             "1",
+				"new_ds_records.0.digest_type": "2",
+				"new_dnskey_records.#":         "1",
+			},
+		},
+		"invalid DS record": {
+			init: func(m *dns.Mock) {
+				m.On("GetZonesDNSSecStatus", anyContext, request).Return(
+					&dns.GetZonesDNSSecStatusResponse{
+						DNSSecStatuses: []dns.SecStatus{{
+							Zone:           "test.zone.net",
+							CurrentRecords: dns.SecRecords{DNSKeyRecord: testDNSKeyRecord, DSRecord: "DUMMY_DS_RECORD"},
+						}},
+					}, nil)
+			},
+			expectedError: regexp.MustCompile("parsing DNSSEC records of zone test.zone.net failed"),
+		},
+		"no DNSSEC status returned": {
+			init: func(m *dns.Mock) {
+				m.On("GetZonesDNSSecStatus", anyContext, request).Return(
+					&dns.GetZonesDNSSecStatusResponse{
+						DNSSecStatuses: []dns.SecStatus{},
+					}, nil)
+			},
+			expectedError: regexp.MustCompile("no DNSSEC status for zone: test.zone.net"),
+		},
+		"error response from api": {
+			init: func(m *dns.Mock) {
+				m.On("GetZonesDNSSecStatus", anyContext, request).Return(
+					nil, fmt.Errorf("API error"))
+			},
+			expectedError: regexp.MustCompile("API error"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &dns.Mock{}
+			test.init(client)
+			var checkFuncs []resource.TestCheckFunc
+			for k, v := range test.expectedAttributes {
+				checkFuncs = append(checkFuncs, resource.TestCheckResourceAttr("data.akamai_zone_dnssec_keys.test", k, v))
+			}
+
+			useClient(client, func() {
+				resource.Test(t, resource.TestCase{
+					IsUnitTest:               true,
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestDataZoneDnsSecKeys/valid.tf"),
+						Check:       resource.ComposeAggregateTestCheckFunc(checkFuncs...),
+						ExpectError: test.expectedError,
+					}},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone to export",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Records of the zone in the RFC 1035 master file format, without read-only AKAMAITLC records",
+			},
+			"record_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of exported records",
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "dataSourceDNSZoneFileRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithField("zone", zone).Debug("Exporting zone file")
+
+	exported, err := inst.Client(meta).GetMasterZoneFile(ctx, dns.GetMasterZoneFileRequest{Zone: zone})
+	if err != nil {
+		return diag.Errorf("failed to read zone file of %s: %s", zone, err)
+	}
+	records, err := parseZoneFile(zone, exported)
+	if err != nil {
+		return diag.Errorf("failed to parse zone file of %s: %s", zone, err)
+	}
+
+	attrs := map[string]interface{}{
+		"content":      formatZoneFile(records),
+		"record_count": len(zoneFileKeys(records)),
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zone)
+	return nil
+}
@@ -0,0 +1,61 @@
+package dns
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceDNSZoneFile(t *testing.T) {
+	t.Run("zone is exported", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetMasterZoneFile",
+			mock.Anything,
+			dns.GetMasterZoneFileRequest{Zone: "exampleterraform.io"},
+		).Return("$ORIGIN exampleterraform.io.\n@ 300 IN AKAMAITLC 1 192.0.2.1\nwww 300 IN A 192.0.2.10\n", nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestDataDnsZoneFile/basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_dns_zone_file.test", "id", "exampleterraform.io"),
+							resource.TestCheckResourceAttr("data.akamai_dns_zone_file.test", "content", "www.exampleterraform.io.\t300\tIN\tA\t192.0.2.10\n"),
+							resource.TestCheckResourceAttr("data.akamai_dns_zone_file.test", "record_count", "1"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("export fails", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetMasterZoneFile",
+			mock.Anything,
+			dns.GetMasterZoneFileRequest{Zone: "exampleterraform.io"},
+		).Return("", &dns.Error{StatusCode: 404, Title: "Not Found"})
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestDataDnsZoneFile/basic.tf"),
+						ExpectError: regexp.MustCompile("failed to read zone file of exampleterraform.io"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
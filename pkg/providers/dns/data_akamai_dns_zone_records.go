@@ -0,0 +1,263 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/svcparams"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/txtrecord"
+	"github.com/apex/log"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// zoneRecordsPageSize is the default number of recordsets read in a single request
+const zoneRecordsPageSize = 100
+
+func dataSourceDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone whose recordsets are listed",
+			},
+			"record_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Record types of the listed recordsets. All types are listed when not set",
+			},
+			"name_pattern": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNamePattern,
+				Description:      "Shell pattern, e.g. '*.example.com', the names of the listed recordsets match, case insensitively",
+			},
+			"page_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          zoneRecordsPageSize,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Number of recordsets read in a single request",
+			},
+			"recordsets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Recordsets of the zone, ordered by name and type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the recordset",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Record type of the recordset",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL of the recordset",
+						},
+						"rdata": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Rdata of the records as returned by the API",
+						},
+						"normalized_rdata": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Rdata of the records normalized the way akamai_dns_record compares targets, sorted",
+						},
+						"import_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID to import the recordset as akamai_dns_record",
+						},
+					},
+				},
+			},
+			"record_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of listed recordsets",
+			},
+		},
+	}
+}
+
+func validateNamePattern(v interface{}, p cty.Path) diag.Diagnostics {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("invalid name pattern %q: %s", v, err),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
+
+func dataSourceDNSZoneRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "dataSourceDNSZoneRecordsRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	recordTypes, err := tf.GetTypedListValue[string]("record_types", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	namePattern, err := tf.GetStringValue("name_pattern", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	pageSize, err := tf.GetIntValue("page_size", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logger.WithFields(log.Fields{
+		"zone":         zone,
+		"record_types": recordTypes,
+		"name_pattern": namePattern,
+	}).Debug("Listing recordsets")
+
+	recordSets, err := getRecordSetPages(ctx, inst.Client(meta), zone, recordTypes, pageSize)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result []interface{}
+	for _, recordSet := range recordSets {
+		if namePattern != "" {
+			if ok, _ := path.Match(strings.ToLower(namePattern), strings.ToLower(recordSet.Name)); !ok {
+				continue
+			}
+		}
+		normalized := make([]string, 0, len(recordSet.Rdata))
+		for _, rdata := range recordSet.Rdata {
+			normalized = append(normalized, normalizeRdata(recordSet.Type, rdata))
+		}
+		sort.Strings(normalized)
+		result = append(result, map[string]interface{}{
+			"name":             recordSet.Name,
+			"record_type":      recordSet.Type,
+			"ttl":              recordSet.TTL,
+			"rdata":            recordSet.Rdata,
+			"normalized_rdata": normalized,
+			"import_id":        fmt.Sprintf("%s#%s#%s", zone, recordSet.Name, recordSet.Type),
+		})
+	}
+
+	attrs := map[string]interface{}{
+		"recordsets":   result,
+		"record_count": len(result),
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zone)
+	return nil
+}
+
+// getRecordSetPages reads the recordsets of the zone page by page, optionally only those of the given types,
+// and returns them ordered by name and type
+func getRecordSetPages(ctx context.Context, client dns.DNS, zone string, recordTypes []string, pageSize int) ([]dns.RecordSet, error) {
+	var recordSets []dns.RecordSet
+	for page := 1; ; page++ {
+		resp, err := client.GetRecordSets(ctx, dns.GetRecordSetsRequest{
+			Zone: zone,
+			QueryArgs: &dns.RecordSetQueryArgs{
+				Page:     page,
+				PageSize: pageSize,
+				Types:    strings.Join(recordTypes, ","),
+				SortBy:   "name,type",
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read page %d of recordsets of zone %s: %w", page, zone, err)
+		}
+		recordSets = append(recordSets, resp.RecordSets...)
+		if len(resp.RecordSets) == 0 || page >= resp.Metadata.LastPage {
+			break
+		}
+	}
+
+	sort.SliceStable(recordSets, func(i, j int) bool {
+		if recordSets[i].Name != recordSets[j].Name {
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+	return recordSets, nil
+}
+
+// normalizeRdata normalizes the rdata of a record the way akamai_dns_record compares targets: TXT and SPF strings
+// are quoted and escaped, addresses are in their shortest form, trailing dots of names are removed, CAA values
+// are unquoted, and service parameters are canonical
+func normalizeRdata(recordType, rdata string) string {
+	switch recordType {
+	case RRTypeTxt, RRTypeSpf:
+		if normalized, err := txtrecord.NormalizeTarget(rdata); err == nil {
+			return normalized
+		}
+		return rdata
+	case RRTypeCaa:
+		return strings.Join(strings.Fields(strings.ReplaceAll(rdata, `"`, "")), " ")
+	}
+
+	entries, err := splitZoneFile(rdata)
+	if err != nil || len(entries) != 1 {
+		return rdata
+	}
+	fields := entries[0].tokens
+	if n, ok := zoneFileTrailingFields[recordType]; ok && len(fields) > n+1 {
+		fields = append(fields[:n], strings.Join(fields[n:], ""))
+	}
+	for _, i := range zoneFileNameFields[recordType] {
+		if i < len(fields) && fields[i] != "." {
+			fields[i] = strings.TrimRight(fields[i], ".")
+		}
+	}
+	switch recordType {
+	case RRTypeA, RRTypeAaaa:
+		if addr, err := netip.ParseAddr(fields[0]); err == nil {
+			fields[0] = addr.String()
+		}
+	case RRTypeDs, RRTypeTlsa, RRTypeSshfp:
+		last := len(fields) - 1
+		fields[last] = strings.ToUpper(fields[last])
+	case RRTypeSvcb, RRTypeHTTPS:
+		if len(fields) > 2 {
+			if params, err := svcparams.Canonical(strings.Join(fields[2:], " ")); err == nil {
+				fields = append(fields[:2], params)
+			}
+		}
+	}
+	return strings.Join(fields, " ")
+}
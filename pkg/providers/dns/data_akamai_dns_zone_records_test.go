@@ -0,0 +1,126 @@
+package dns
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRdata(t *testing.T) {
+	tests := []struct {
+		recordType string
+		rdata      string
+		expected   string
+	}{
+		{RRTypeA, "192.0.2.1", "192.0.2.1"},
+		{RRTypeAaaa, "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{RRTypeCname, "target.example.com.", "target.example.com"},
+		{RRTypeMx, "10 mail.example.com.", "10 mail.example.com"},
+		{RRTypeSrv, "10 60 5060 sip.example.com.", "10 60 5060 sip.example.com"},
+		{RRTypeCaa, `0 issue "ca.example.net"`, "0 issue ca.example.net"},
+		{RRTypeTxt, "v=spf1 -all", `"v=spf1" "-all"`},
+		{RRTypeTxt, `"v=spf1 -all"`, `"v=spf1 -all"`},
+		{RRTypeDs, "60485 5 1 2bb183af5f22588179a53b0a9863 1fad1a292118", "60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"},
+		{RRTypeHTTPS, `1 svc.example.com. port=8443 alpn=h2,h3`, `1 svc.example.com alpn="h2,h3" port=8443`},
+		{RRTypeHTTPS, "0 svc.example.com.", "0 svc.example.com"},
+	}
+	for _, test := range tests {
+		t.Run(test.recordType+" "+test.rdata, func(t *testing.T) {
+			assert.Equal(t, test.expected, normalizeRdata(test.recordType, test.rdata))
+		})
+	}
+}
+
+func TestGetRecordSetPages(t *testing.T) {
+	client := &dns.Mock{}
+	page := func(number int) dns.GetRecordSetsRequest {
+		return dns.GetRecordSetsRequest{
+			Zone:      "example.com",
+			QueryArgs: &dns.RecordSetQueryArgs{Page: number, PageSize: 2, Types: "A,TXT", SortBy: "name,type"},
+		}
+	}
+	client.On("GetRecordSets", mock.Anything, page(1)).Return(&dns.GetRecordSetsResponse{
+		Metadata: dns.Metadata{Page: 1, PageSize: 2, LastPage: 2, TotalElements: 3},
+		RecordSets: []dns.RecordSet{
+			{Name: "www.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"hello"`}},
+			{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}},
+		},
+	}, nil).Once()
+	client.On("GetRecordSets", mock.Anything, page(2)).Return(&dns.GetRecordSetsResponse{
+		Metadata:   dns.Metadata{Page: 2, PageSize: 2, LastPage: 2, TotalElements: 3},
+		RecordSets: []dns.RecordSet{{Name: "api.example.com", Type: "A", TTL: 60, Rdata: []string{"192.0.2.2"}}},
+	}, nil).Once()
+
+	recordSets, err := getRecordSetPages(context.Background(), client, "example.com", []string{"A", "TXT"}, 2)
+	require.NoError(t, err)
+	require.Len(t, recordSets, 3)
+	assert.Equal(t, "api.example.com", recordSets[0].Name)
+	assert.Equal(t, "A", recordSets[1].Type)
+	assert.Equal(t, "TXT", recordSets[2].Type)
+	client.AssertExpectations(t)
+}
+
+func TestDataDNSZoneRecords(t *testing.T) {
+	dataSourceName := "data.akamai_dns_zone_records.test"
+
+	t.Run("filters", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetRecordSets", mock.Anything, dns.GetRecordSetsRequest{
+			Zone:      "example.com",
+			QueryArgs: &dns.RecordSetQueryArgs{Page: 1, PageSize: 2, Types: "A,TXT", SortBy: "name,type"},
+		}).Return(&dns.GetRecordSetsResponse{
+			Metadata: dns.Metadata{Page: 1, PageSize: 2, LastPage: 1, TotalElements: 2},
+			RecordSets: []dns.RecordSet{
+				{Name: "example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}},
+				{Name: "www.example.com", Type: "TXT", TTL: 300, Rdata: []string{"hello world"}},
+			},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestDataDnsZoneRecords/filters.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "id", "example.com"),
+							resource.TestCheckResourceAttr(dataSourceName, "record_count", "1"),
+							resource.TestCheckResourceAttr(dataSourceName, "recordsets.0.name", "www.example.com"),
+							resource.TestCheckResourceAttr(dataSourceName, "recordsets.0.record_type", "TXT"),
+							resource.TestCheckResourceAttr(dataSourceName, "recordsets.0.rdata.0", "hello world"),
+							resource.TestCheckResourceAttr(dataSourceName, "recordsets.0.normalized_rdata.0", `"hello" "world"`),
+							resource.TestCheckResourceAttr(dataSourceName, "recordsets.0.import_id", "example.com#www.example.com#TXT"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid name pattern", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestDataDnsZoneRecords/invalid_pattern.tf"),
+						ExpectError: regexp.MustCompile(`invalid name pattern`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
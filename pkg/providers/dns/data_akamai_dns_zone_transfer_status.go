@@ -0,0 +1,117 @@
+package dns
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSZoneTransferStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneTransferStatusRead,
+		Schema: map[string]*schema.Schema{
+			"zones": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the secondary zones whose zone transfer status is read",
+			},
+			"zone_transfer_statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Status of the last zone transfer of each zone, ordered by zone name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the zone",
+						},
+						"masters": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Master servers the zone is transferred from",
+						},
+						"last_transfer_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last zone transfer attempt, in ISO 8601 format",
+						},
+						"last_transfer_serial": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "SOA serial of the last transferred version of the zone",
+						},
+						"last_transfer_result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Result of the last zone transfer attempt",
+						},
+						"last_transfer_error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error of the last zone transfer attempt, empty when it succeeded",
+						},
+						"last_notify_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last NOTIFY request received from the masters, in ISO 8601 format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneTransferStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "dataSourceDNSZoneTransferStatusRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zones, err := tf.GetTypedListValue[string]("zones", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithField("zones", zones).Debug("Reading zone transfer status")
+
+	statuses, err := zoneTransfersClientFor(meta).GetZoneTransferStatus(ctx, zones)
+	if err != nil {
+		return diag.Errorf("failed to read zone transfer status of zones %v: %s", zones, err)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Zone < statuses[j].Zone
+	})
+
+	result := make([]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, map[string]interface{}{
+			"zone":                 status.Zone,
+			"masters":              status.MasterServers,
+			"last_transfer_date":   status.LastTransferDate,
+			"last_transfer_serial": status.LastTransferSerial,
+			"last_transfer_result": status.LastTransferResult,
+			"last_transfer_error":  status.LastTransferError,
+			"last_notify_date":     status.LastNotifyDate,
+		})
+	}
+	if err := tf.SetAttrs(d, map[string]interface{}{"zone_transfer_statuses": result}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	sorted := append([]string(nil), zones...)
+	sort.Strings(sorted)
+	d.SetId(strings.Join(sorted, ","))
+	return nil
+}
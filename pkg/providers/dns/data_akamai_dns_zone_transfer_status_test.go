@@ -0,0 +1,57 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataDNSZoneTransferStatus(t *testing.T) {
+	dataSourceName := "data.akamai_dns_zone_transfer_status.test"
+
+	zoneTransfers := &mockZoneTransfers{}
+	zoneTransfers.On("GetZoneTransferStatus", mock.Anything, []string{"secondary2.example.com", "secondary1.example.com"}).Return([]zoneTransferStatus{
+		{
+			Zone:               "secondary2.example.com",
+			MasterServers:      []string{"192.0.2.2"},
+			LastTransferDate:   "2026-10-19T09:00:00Z",
+			LastTransferSerial: 41,
+			LastTransferResult: "FAILURE",
+			LastTransferError:  "TSIG verification failed",
+		},
+		{
+			Zone:               "secondary1.example.com",
+			MasterServers:      []string{"192.0.2.1"},
+			LastTransferDate:   "2026-10-19T10:00:00Z",
+			LastTransferSerial: 2026101901,
+			LastTransferResult: "SUCCESS",
+		},
+	}, nil)
+
+	useZoneTransfers(zoneTransfers, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDataDnsZoneTransferStatus/zones.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "id", "secondary1.example.com,secondary2.example.com"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.#", "2"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.0.zone", "secondary1.example.com"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.0.masters.0", "192.0.2.1"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.0.last_transfer_serial", "2026101901"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.0.last_transfer_error", ""),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.1.zone", "secondary2.example.com"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.1.last_transfer_date", "2026-10-19T09:00:00Z"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.1.last_transfer_result", "FAILURE"),
+						resource.TestCheckResourceAttr(dataSourceName, "zone_transfer_statuses.1.last_transfer_error", "TSIG verification failed"),
+					),
+				},
+			},
+		})
+	})
+
+	zoneTransfers.AssertExpectations(t)
+}
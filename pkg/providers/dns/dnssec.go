@@ -0,0 +1,175 @@
+package dns
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+)
+
+// dnssecKeys covers the DNSSEC key operations of the Edge DNS API which are not available in the edgegrid client yet
+type dnssecKeys interface {
+	// RolloverKeys generates new DNSSEC keys for a sign-and-serve zone. The new DS and DNSKEY records
+	// are reported as new records in the DNSSEC status of the zone until the rollover completes
+	RolloverKeys(context.Context, string) error
+}
+
+type (
+	dnssecKeysClient struct {
+		session session.Session
+	}
+
+	// dsRecord is the rdata of a DS record
+	dsRecord struct {
+		KeyTag     int
+		Algorithm  int
+		DigestType int
+		Digest     string
+	}
+
+	// dnskeyRecord is the rdata of a DNSKEY record
+	dnskeyRecord struct {
+		Flags     int
+		Protocol  int
+		Algorithm int
+		Key       string
+	}
+)
+
+var (
+	// ErrRolloverKeys is returned when starting a DNSSEC key rollover fails
+	ErrRolloverKeys = errors.New("rolling over DNSSEC keys")
+	// ErrDNSSECRecord is returned when a DS or DNSKEY record reported by the API cannot be parsed
+	ErrDNSSECRecord = errors.New("invalid DNSSEC record")
+)
+
+func (c *dnssecKeysClient) RolloverKeys(ctx context.Context, zone string) error {
+	uri := fmt.Sprintf("/config-dns/v2/zones/%s/key-rollover", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrRolloverKeys, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrRolloverKeys, err)
+	}
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %w", ErrRolloverKeys, responseError(resp))
+	}
+
+	return nil
+}
+
+// parseDSRecords parses DS records, one per line, given either as rdata or in the master file format
+func parseDSRecords(records string) ([]dsRecord, error) {
+	entries, err := dnssecRecordFields(records, RRTypeDs)
+	if err != nil {
+		return nil, err
+	}
+	var result []dsRecord
+	for _, fields := range entries {
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("%w: DS record expects 4 fields (keytag, algorithm, digest type, digest), got %d", ErrDNSSECRecord, len(fields))
+		}
+		numbers, err := atoiFields(RRTypeDs, fields[:3])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, dsRecord{
+			KeyTag:     numbers[0],
+			Algorithm:  numbers[1],
+			DigestType: numbers[2],
+			Digest:     strings.ToUpper(strings.Join(fields[3:], "")),
+		})
+	}
+	return result, nil
+}
+
+// parseDNSKeyRecords parses DNSKEY records, one per line, given either as rdata or in the master file format
+func parseDNSKeyRecords(records string) ([]dnskeyRecord, error) {
+	entries, err := dnssecRecordFields(records, RRTypeDnskey)
+	if err != nil {
+		return nil, err
+	}
+	var result []dnskeyRecord
+	for _, fields := range entries {
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("%w: DNSKEY record expects 4 fields (flags, protocol, algorithm, key), got %d", ErrDNSSECRecord, len(fields))
+		}
+		numbers, err := atoiFields(RRTypeDnskey, fields[:3])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, dnskeyRecord{
+			Flags:     numbers[0],
+			Protocol:  numbers[1],
+			Algorithm: numbers[2],
+			Key:       strings.Join(fields[3:], ""),
+		})
+	}
+	return result, nil
+}
+
+// dnssecRecordFields returns the rdata fields of every record of the given type.
+// Owner, TTL, class and type are skipped when the records are in the master file format.
+func dnssecRecordFields(records, recordType string) ([][]string, error) {
+	entries, err := splitZoneFile(records)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDNSSECRecord, err)
+	}
+	result := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		fields := entry.tokens
+		for i, field := range fields {
+			if strings.EqualFold(field, recordType) {
+				fields = fields[i+1:]
+				break
+			}
+		}
+		result = append(result, fields)
+	}
+	return result, nil
+}
+
+func atoiFields(recordType string, fields []string) ([]int, error) {
+	numbers := make([]int, 0, len(fields))
+	for _, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s record field must be a number, got %q", ErrDNSSECRecord, recordType, field)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// KeyTag computes the key tag of the DNSKEY record as defined by RFC 4034, Appendix B
+func (r dnskeyRecord) KeyTag() (int, error) {
+	key, err := base64.StdEncoding.DecodeString(r.Key)
+	if err != nil {
+		return 0, fmt.Errorf("%w: DNSKEY key is not valid base64: %s", ErrDNSSECRecord, err)
+	}
+
+	rdata := make([]byte, 4, 4+len(key))
+	binary.BigEndian.PutUint16(rdata, uint16(r.Flags))
+	rdata[2], rdata[3] = byte(r.Protocol), byte(r.Algorithm)
+	rdata = append(rdata, key...)
+
+	var ac uint32
+	for i, b := range rdata {
+		if i&1 == 0 {
+			ac += uint32(b) << 8
+		} else {
+			ac += uint32(b)
+		}
+	}
+	ac += ac >> 16 & 0xFFFF
+	return int(ac & 0xFFFF), nil
+}
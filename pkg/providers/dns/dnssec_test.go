@@ -0,0 +1,108 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// DNSKEY and DS records of the example in RFC 4034, section 5.4
+const (
+	testDNSKeyRecord = `example.com. 86400 IN DNSKEY 256 3 5 ( AQOeiiR0GOMYkDshWoSKz9Xz
+		fwJr1AYtsmx3TGkJaNXVbfi/ 2pHm822aJ5iI9BMzNXxeYCmZ DRD99WYwYqUSdjMmmAphXdvx
+		egXd/M5+X7OrzKBaMbCVdFLU Uh6DhweJBjEVv5f2wwjM9Xzc nOf+EPbtG9DMBmADjFDc2w/r
+		ljwvFw== )`
+	testDSRecord = "example.com. 86400 IN DS 60485 5 1 2bb183af5f22588179a53b0a98631fad1a292118"
+)
+
+type mockDNSSecKeys struct {
+	mock.Mock
+}
+
+func (m *mockDNSSecKeys) RolloverKeys(ctx context.Context, zone string) error {
+	args := m.Called(ctx, zone)
+	return args.Error(0)
+}
+
+func TestParseDSRecords(t *testing.T) {
+	records, err := parseDSRecords(testDSRecord + "\n60485 5 2 d4b7d520e7bb5f0f67674a0cceb1e3e0614b93c4f9e99b83 83f6a1e4469da50a\n")
+	require.NoError(t, err)
+	assert.Equal(t, []dsRecord{
+		{KeyTag: 60485, Algorithm: 5, DigestType: 1, Digest: "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+		{KeyTag: 60485, Algorithm: 5, DigestType: 2, Digest: "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"},
+	}, records)
+
+	_, err = parseDSRecords("60485 RSASHA1 1 2BB183AF")
+	assert.True(t, errors.Is(err, ErrDNSSECRecord))
+	_, err = parseDSRecords("60485 5 1")
+	assert.True(t, errors.Is(err, ErrDNSSECRecord))
+}
+
+func TestParseDNSKeyRecords(t *testing.T) {
+	records, err := parseDNSKeyRecords(testDNSKeyRecord)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, 256, records[0].Flags)
+	assert.Equal(t, 3, records[0].Protocol)
+	assert.Equal(t, 5, records[0].Algorithm)
+
+	keyTag, err := records[0].KeyTag()
+	require.NoError(t, err)
+	assert.Equal(t, 60485, keyTag)
+
+	_, err = dnskeyRecord{Flags: 257, Protocol: 3, Algorithm: 13, Key: "not base64!"}.KeyTag()
+	assert.True(t, errors.Is(err, ErrDNSSECRecord))
+}
+
+func TestDNSSecKeysClient(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/config-dns/v2/zones/example.com/key-rollover", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer mockServer.Close()
+
+	client := &dnssecKeysClient{session: mockSession(t, mockServer)}
+	assert.NoError(t, client.RolloverKeys(context.Background(), "example.com"))
+}
+
+func TestWaitForDNSSecRollover(t *testing.T) {
+	dnssecRolloverPollMinimum, dnssecRolloverPollInterval = time.Millisecond, time.Millisecond
+	request := dns.GetZonesDNSSecStatusRequest{Zones: []string{"example.com"}}
+	current := dns.SecRecords{DSRecord: "1 13 2 AA", DNSKeyRecord: "257 3 13 AA=="}
+
+	t.Run("new keys are generated", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetZonesDNSSecStatus", mock.Anything, request).Return(&dns.GetZonesDNSSecStatusResponse{
+			DNSSecStatuses: []dns.SecStatus{{Zone: "example.com", CurrentRecords: current}},
+		}, nil).Twice()
+		client.On("GetZonesDNSSecStatus", mock.Anything, request).Return(&dns.GetZonesDNSSecStatusResponse{
+			DNSSecStatuses: []dns.SecStatus{{Zone: "example.com", CurrentRecords: current, NewRecords: &dns.SecRecords{DSRecord: testDSRecord}}},
+		}, nil).Once()
+
+		status, err := waitForDNSSecRollover(context.Background(), client, "example.com")
+		require.NoError(t, err)
+		assert.Equal(t, testDSRecord, status.NewRecords.DSRecord)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetZonesDNSSecStatus", mock.Anything, request).Return(&dns.GetZonesDNSSecStatusResponse{
+			DNSSecStatuses: []dns.SecStatus{{Zone: "example.com", CurrentRecords: current}},
+		}, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := waitForDNSSecRollover(ctx, client, "example.com")
+		assert.True(t, errors.Is(err, ErrDNSSECRolloverTimeout), err)
+	})
+}
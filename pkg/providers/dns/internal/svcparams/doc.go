@@ -0,0 +1,3 @@
+// Package svcparams contains logic used for parsing, validating and formatting the service parameters of DNS records
+// of type SVCB and HTTPS, as defined by RFC 9460.
+package svcparams
@@ -0,0 +1,405 @@
+package svcparams
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Names of the service parameter keys registered by RFC 9460
+const (
+	KeyMandatory     = "mandatory"
+	KeyALPN          = "alpn"
+	KeyNoDefaultALPN = "no-default-alpn"
+	KeyPort          = "port"
+	KeyIPv4Hint      = "ipv4hint"
+	KeyECH           = "ech"
+	KeyIPv6Hint      = "ipv6hint"
+)
+
+const (
+	genericKeyPrefix = "key"
+	reservedKey      = 65535
+	maxALPNLength    = 255
+)
+
+var (
+	// keyNumbers maps the names of the registered keys to their numbers
+	keyNumbers = map[string]uint16{
+		KeyMandatory:     0,
+		KeyALPN:          1,
+		KeyNoDefaultALPN: 2,
+		KeyPort:          3,
+		KeyIPv4Hint:      4,
+		KeyECH:           5,
+		KeyIPv6Hint:      6,
+	}
+
+	// ErrInvalid is returned when the service parameters are malformed or break the rules of RFC 9460
+	ErrInvalid = errors.New("invalid service parameters")
+)
+
+// Params are the service parameters of an SVCB or HTTPS record
+type Params struct {
+	// Mandatory lists the keys which clients must support to use the record
+	Mandatory []string
+	// ALPN lists the protocol identifiers supported by the service, in the order of preference
+	ALPN []string
+	// NoDefaultALPN is set when the service does not support the default protocol of the scheme
+	NoDefaultALPN bool
+	// Port is the alternative port of the service, nil when not set
+	Port *int
+	// IPv4Hint lists IPv4 addresses of the service
+	IPv4Hint []string
+	// ECH is the Encrypted ClientHello configuration list in base64
+	ECH string
+	// IPv6Hint lists IPv6 addresses of the service
+	IPv6Hint []string
+	// Generic holds the values of keys given in the keyNNNNN form, by key number
+	Generic map[uint16]string
+}
+
+type param struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// Parse parses service parameters in the presentation format, e.g. `alpn="h2,h3" port=8443`, and validates them
+func Parse(s string) (*Params, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Params{}
+	seen := make(map[uint16]struct{}, len(tokens))
+	for _, t := range tokens {
+		number, err := keyNumber(t.key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[number]; ok {
+			return nil, fmt.Errorf("%w: key %s is repeated", ErrInvalid, keyName(number))
+		}
+		seen[number] = struct{}{}
+		if err := p.set(number, t); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Params) set(number uint16, t param) error {
+	name := keyName(number)
+	if number != keyNumbers[KeyNoDefaultALPN] && number <= keyNumbers[KeyIPv6Hint] && t.value == "" {
+		return fmt.Errorf("%w: key %s requires a value", ErrInvalid, name)
+	}
+
+	switch name {
+	case KeyMandatory:
+		p.Mandatory = splitList(t.value)
+	case KeyALPN:
+		p.ALPN = splitList(t.value)
+	case KeyNoDefaultALPN:
+		if t.hasValue {
+			return fmt.Errorf("%w: key %s does not take a value", ErrInvalid, name)
+		}
+		p.NoDefaultALPN = true
+	case KeyPort:
+		port, err := strconv.Atoi(unescape(t.value))
+		if err != nil {
+			return fmt.Errorf("%w: port must be a number, got %q", ErrInvalid, t.value)
+		}
+		p.Port = &port
+	case KeyIPv4Hint:
+		p.IPv4Hint = splitList(t.value)
+	case KeyECH:
+		p.ECH = unescape(t.value)
+	case KeyIPv6Hint:
+		p.IPv6Hint = splitList(t.value)
+	default:
+		if p.Generic == nil {
+			p.Generic = make(map[uint16]string)
+		}
+		p.Generic[number] = unescape(t.value)
+	}
+	return nil
+}
+
+// Validate checks the values of the parameters and the rules of RFC 9460 between them
+func (p *Params) Validate() error {
+	var errs []error
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalid}, args...)...))
+	}
+
+	present := p.keys()
+	mandatory := make(map[uint16]struct{}, len(p.Mandatory))
+	for _, key := range p.Mandatory {
+		number, err := keyNumber(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if number == keyNumbers[KeyMandatory] {
+			addErr("mandatory must not list itself")
+			continue
+		}
+		if _, ok := mandatory[number]; ok {
+			addErr("mandatory lists key %s more than once", keyName(number))
+			continue
+		}
+		mandatory[number] = struct{}{}
+		if _, ok := present[number]; !ok {
+			addErr("mandatory key %s is not set", keyName(number))
+		}
+	}
+
+	for _, id := range p.ALPN {
+		if id == "" || len(id) > maxALPNLength {
+			addErr("alpn identifiers must be 1 to %d characters long, got %q", maxALPNLength, id)
+		}
+	}
+	if p.NoDefaultALPN && len(p.ALPN) == 0 {
+		addErr("no-default-alpn requires alpn to be set")
+	}
+	if p.Port != nil && (*p.Port < 0 || *p.Port > 65535) {
+		addErr("port must be between 0 and 65535, got %d", *p.Port)
+	}
+	for _, hint := range p.IPv4Hint {
+		if addr, err := netip.ParseAddr(hint); err != nil || !addr.Is4() {
+			addErr("ipv4hint %q is not an IPv4 address", hint)
+		}
+	}
+	for _, hint := range p.IPv6Hint {
+		if addr, err := netip.ParseAddr(hint); err != nil || !addr.Is6() || addr.Zone() != "" {
+			addErr("ipv6hint %q is not an IPv6 address", hint)
+		}
+	}
+	if p.ECH != "" {
+		if _, err := base64.StdEncoding.DecodeString(p.ECH); err != nil {
+			addErr("ech must be base64 encoded: %s", err)
+		}
+	}
+	for number := range p.Generic {
+		if number <= keyNumbers[KeyIPv6Hint] || number == reservedKey {
+			addErr("key%d cannot be set in the generic form", number)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// String returns the parameters in the canonical presentation format: keys are ordered by their numbers,
+// known keys are given by name and addresses are in their shortest form
+func (p *Params) String() string {
+	var params []string
+	if len(p.Mandatory) > 0 {
+		numbers := make([]int, 0, len(p.Mandatory))
+		for _, key := range p.Mandatory {
+			if number, err := keyNumber(key); err == nil {
+				numbers = append(numbers, int(number))
+			}
+		}
+		sort.Ints(numbers)
+		keys := make([]string, 0, len(numbers))
+		for _, number := range numbers {
+			keys = append(keys, keyName(uint16(number)))
+		}
+		params = append(params, KeyMandatory+"="+strings.Join(keys, ","))
+	}
+	if len(p.ALPN) > 0 {
+		ids := make([]string, 0, len(p.ALPN))
+		for _, id := range p.ALPN {
+			ids = append(ids, escapeListItem(id))
+		}
+		params = append(params, KeyALPN+`="`+strings.Join(ids, ",")+`"`)
+	}
+	if p.NoDefaultALPN {
+		params = append(params, KeyNoDefaultALPN)
+	}
+	if p.Port != nil {
+		params = append(params, KeyPort+"="+strconv.Itoa(*p.Port))
+	}
+	if len(p.IPv4Hint) > 0 {
+		params = append(params, KeyIPv4Hint+"="+strings.Join(canonicalAddrs(p.IPv4Hint), ","))
+	}
+	if p.ECH != "" {
+		params = append(params, KeyECH+"="+p.ECH)
+	}
+	if len(p.IPv6Hint) > 0 {
+		params = append(params, KeyIPv6Hint+"="+strings.Join(canonicalAddrs(p.IPv6Hint), ","))
+	}
+
+	numbers := make([]int, 0, len(p.Generic))
+	for number := range p.Generic {
+		numbers = append(numbers, int(number))
+	}
+	sort.Ints(numbers)
+	for _, number := range numbers {
+		param := keyName(uint16(number))
+		if value := p.Generic[uint16(number)]; value != "" {
+			param += `="` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+		}
+		params = append(params, param)
+	}
+	return strings.Join(params, " ")
+}
+
+// Canonical returns the canonical presentation format of the service parameters
+func Canonical(s string) (string, error) {
+	p, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+func (p *Params) keys() map[uint16]struct{} {
+	keys := make(map[uint16]struct{})
+	add := func(name string, set bool) {
+		if set {
+			keys[keyNumbers[name]] = struct{}{}
+		}
+	}
+	add(KeyMandatory, len(p.Mandatory) > 0)
+	add(KeyALPN, len(p.ALPN) > 0)
+	add(KeyNoDefaultALPN, p.NoDefaultALPN)
+	add(KeyPort, p.Port != nil)
+	add(KeyIPv4Hint, len(p.IPv4Hint) > 0)
+	add(KeyECH, p.ECH != "")
+	add(KeyIPv6Hint, len(p.IPv6Hint) > 0)
+	for number := range p.Generic {
+		keys[number] = struct{}{}
+	}
+	return keys
+}
+
+// keyNumber returns the number of a key given by its name or in the keyNNNNN form
+func keyNumber(key string) (uint16, error) {
+	if number, ok := keyNumbers[key]; ok {
+		return number, nil
+	}
+	if digits, ok := strings.CutPrefix(key, genericKeyPrefix); ok && digits != "" {
+		number, err := strconv.ParseUint(digits, 10, 16)
+		if err == nil && (len(digits) == 1 || digits[0] != '0') {
+			if number == reservedKey {
+				return 0, fmt.Errorf("%w: key %s is reserved", ErrInvalid, key)
+			}
+			return uint16(number), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown key %q", ErrInvalid, key)
+}
+
+// keyName returns the name of a registered key or the keyNNNNN form of other keys
+func keyName(number uint16) string {
+	for name, n := range keyNumbers {
+		if n == number {
+			return name
+		}
+	}
+	return genericKeyPrefix + strconv.Itoa(int(number))
+}
+
+// tokenize splits the parameters into keys and their values, removing the quotes around values
+func tokenize(s string) ([]param, error) {
+	var params []param
+	isSpace := func(c byte) bool { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+	for i := 0; i < len(s); {
+		if isSpace(s[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(s) && !isSpace(s[i]) && s[i] != '=' {
+			i++
+		}
+		p := param{key: strings.ToLower(s[start:i])}
+		if i < len(s) && s[i] == '=' {
+			p.hasValue = true
+			i++
+			if i < len(s) && s[i] == '"' {
+				i++
+				start = i
+				for i < len(s) && s[i] != '"' {
+					if s[i] == '\\' {
+						i++
+					}
+					i++
+				}
+				if i >= len(s) {
+					return nil, fmt.Errorf("%w: value of key %s is not terminated with a quote", ErrInvalid, p.key)
+				}
+				p.value = s[start:i]
+				i++
+			} else {
+				start = i
+				for i < len(s) && !isSpace(s[i]) {
+					if s[i] == '\\' {
+						i++
+					}
+					i++
+				}
+				p.value = s[start:min(i, len(s))]
+			}
+		}
+		params = append(params, p)
+	}
+	return params, nil
+}
+
+// splitList splits a comma separated value list, where commas within items are escaped with a backslash
+func splitList(value string) []string {
+	var items []string
+	var item strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			i++
+			item.WriteByte(value[i])
+		case value[i] == ',':
+			items = append(items, item.String())
+			item.Reset()
+		default:
+			item.WriteByte(value[i])
+		}
+	}
+	return append(items, item.String())
+}
+
+// unescape removes the backslashes escaping characters of a value
+func unescape(value string) string {
+	var item strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		item.WriteByte(value[i])
+	}
+	return item.String()
+}
+
+func escapeListItem(item string) string {
+	return strings.NewReplacer(`\`, `\\`, `,`, `\,`, `"`, `\"`).Replace(item)
+}
+
+func canonicalAddrs(addrs []string) []string {
+	result := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		if addr, err := netip.ParseAddr(a); err == nil {
+			a = addr.String()
+		}
+		result = append(result, a)
+	}
+	return result
+}
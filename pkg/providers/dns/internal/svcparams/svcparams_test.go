@@ -0,0 +1,99 @@
+package svcparams
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"empty": {
+			in:       "",
+			expected: "",
+		},
+		"keys are ordered by number": {
+			in:       `port=8443 alpn=h2,h3 mandatory=port,alpn`,
+			expected: `mandatory=alpn,port alpn="h2,h3" port=8443`,
+		},
+		"quotes and whitespace": {
+			in:       "  alpn=\"h3\"\tipv4hint=\"192.0.2.1,192.0.2.2\"  ",
+			expected: `alpn="h3" ipv4hint=192.0.2.1,192.0.2.2`,
+		},
+		"generic form of registered keys": {
+			in:       `key1=h2 key3=443 key2`,
+			expected: `alpn="h2" no-default-alpn port=443`,
+		},
+		"addresses are shortened": {
+			in:       `ipv6hint=2001:0db8:0000:0000:0000:0000:0000:0001,2001:DB8::2`,
+			expected: `ipv6hint=2001:db8::1,2001:db8::2`,
+		},
+		"escaped comma in alpn": {
+			in:       `alpn="f\,oo,bar"`,
+			expected: `alpn="f\,oo,bar"`,
+		},
+		"ech and generic keys": {
+			in:       `key667="hello world" ech=AEn+DQBFKwAgACABWIHUGj4u+PIggYXcR5JF0gYk3dCRioBW8uJq9H4mKAAIAAEAAQABAANAEnB1YmxpYy50bHMtZWNoLmRldgAA key668`,
+			expected: `ech=AEn+DQBFKwAgACABWIHUGj4u+PIggYXcR5JF0gYk3dCRioBW8uJq9H4mKAAIAAEAAQABAANAEnB1YmxpYy50bHMtZWNoLmRldgAA key667="hello world" key668`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			canonical, err := Canonical(test.in)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, canonical)
+
+			again, err := Canonical(canonical)
+			require.NoError(t, err)
+			assert.Equal(t, canonical, again)
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"unknown key":                  {in: `foo=bar`, expected: `unknown key "foo"`},
+		"repeated key":                 {in: `port=1 key3=2`, expected: "key port is repeated"},
+		"reserved key":                 {in: `key65535=x`, expected: "key key65535 is reserved"},
+		"leading zero in generic key":  {in: `key0667=x`, expected: `unknown key "key0667"`},
+		"missing value":                {in: `alpn`, expected: "key alpn requires a value"},
+		"value of no-default-alpn":     {in: `alpn=h2 no-default-alpn=1`, expected: "key no-default-alpn does not take a value"},
+		"unterminated quote":           {in: `alpn="h2`, expected: "not terminated with a quote"},
+		"mandatory lists itself":       {in: `mandatory=mandatory,alpn alpn=h2`, expected: "mandatory must not list itself"},
+		"mandatory key is missing":     {in: `mandatory=port alpn=h2`, expected: "mandatory key port is not set"},
+		"mandatory key is repeated":    {in: `mandatory=port,key3 port=1`, expected: "mandatory lists key port more than once"},
+		"empty alpn identifier":        {in: `alpn=h2,,h3`, expected: "alpn identifiers must be 1 to 255 characters long"},
+		"no-default-alpn without alpn": {in: `no-default-alpn`, expected: "no-default-alpn requires alpn to be set"},
+		"port out of range":            {in: `port=65536`, expected: "port must be between 0 and 65535"},
+		"port is not a number":         {in: `port=https`, expected: "port must be a number"},
+		"ipv6 address in ipv4hint":     {in: `ipv4hint=2001:db8::1`, expected: `ipv4hint "2001:db8::1" is not an IPv4 address`},
+		"ipv4 address in ipv6hint":     {in: `ipv6hint=192.0.2.1`, expected: `ipv6hint "192.0.2.1" is not an IPv6 address`},
+		"ech is not base64":            {in: `ech=not-base64!`, expected: "ech must be base64 encoded"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.in)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalid))
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	port := 70000
+	err := (&Params{Port: &port, Generic: map[uint16]string{1: "x"}}).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "port must be between 0 and 65535, got 70000")
+	assert.Contains(t, err.Error(), "key1 cannot be set in the generic form")
+
+	assert.NoError(t, (&Params{ALPN: []string{"h3"}, NoDefaultALPN: true, Mandatory: []string{KeyALPN}}).Validate())
+}
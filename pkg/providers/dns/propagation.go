@@ -0,0 +1,352 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+var (
+	// propagationPollMinimum is the minimum interval between queries of the nameservers
+	propagationPollMinimum = time.Second
+	// propagationPollInterval is the interval between queries of the nameservers
+	propagationPollInterval = 10 * time.Second
+	// propagationTimeout is the default time to wait for the nameservers to serve the records
+	propagationTimeout = 20 * time.Minute
+	// propagationQueryTimeout limits the time of a single query
+	propagationQueryTimeout = 5 * time.Second
+
+	// propagationRecordTypes are the record types whose propagation can be verified
+	propagationRecordTypes = map[string]dnsmessage.Type{
+		RRTypeA:     dnsmessage.TypeA,
+		RRTypeAaaa:  dnsmessage.TypeAAAA,
+		RRTypeCname: dnsmessage.TypeCNAME,
+		RRTypeMx:    dnsmessage.TypeMX,
+		RRTypeNs:    dnsmessage.TypeNS,
+		RRTypePtr:   dnsmessage.TypePTR,
+		RRTypeSrv:   dnsmessage.TypeSRV,
+		RRTypeTxt:   dnsmessage.TypeTXT,
+	}
+
+	// ErrPropagationTimeout is returned when the nameservers do not serve the expected records in time
+	ErrPropagationTimeout = errors.New("timed out waiting for the records to be served")
+	// ErrPropagationRecordType is returned when propagation of the record type cannot be verified
+	ErrPropagationRecordType = errors.New("propagation cannot be verified for record type")
+	// ErrNameserverQuery is returned when a nameserver cannot be queried
+	ErrNameserverQuery = errors.New("querying nameserver")
+)
+
+// propagationRecordTypeNames returns the sorted names of record types whose propagation can be verified
+func propagationRecordTypeNames() []string {
+	names := make([]string, 0, len(propagationRecordTypes))
+	for name := range propagationRecordTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validatePropagation checks the propagation of the record can be verified when wait_for_propagation is set
+func validatePropagation(d *schema.ResourceData) error {
+	if !d.Get("wait_for_propagation").(bool) {
+		return nil
+	}
+	recordType := d.Get("recordtype").(string)
+	if _, ok := propagationRecordTypes[recordType]; !ok {
+		return fmt.Errorf("%w %s, supported types are %s", ErrPropagationRecordType, recordType, strings.Join(propagationRecordTypeNames(), ", "))
+	}
+	return nil
+}
+
+// waitForRecordPropagation waits until the nameservers of the zone serve the record when wait_for_propagation is set
+func waitForRecordPropagation(ctx context.Context, meta meta.Meta, d *schema.ResourceData, zone string, record dns.RecordBody, logger log.Interface) error {
+	if !d.Get("wait_for_propagation").(bool) {
+		return nil
+	}
+
+	nameservers, err := propagationNameservers(ctx, inst.Client(meta), d, "propagation_resolvers", zone)
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(log.Fields{
+		"name":        record.Name,
+		"recordtype":  record.RecordType,
+		"nameservers": nameservers,
+	}).Info("Waiting for record propagation")
+	return waitForPropagation(ctx, nameservers, record.Name, record.RecordType, record.Target, logger)
+}
+
+// readAfterRecordPropagation waits for the record propagation and reads the saved record into the state.
+// The record is read even when the wait fails, so that the state reflects what was saved.
+func readAfterRecordPropagation(ctx context.Context, meta meta.Meta, d *schema.ResourceData, zone string, record dns.RecordBody, logger log.Interface) diag.Diagnostics {
+	waitErr := waitForRecordPropagation(ctx, meta, d, zone, record, logger)
+
+	// the deadline of the operation may have passed while waiting
+	diags := resourceDNSRecordRead(context.WithoutCancel(ctx), d, meta)
+	if waitErr != nil {
+		return append(diags, diag.FromErr(waitErr)...)
+	}
+	return diags
+}
+
+// propagationNameservers returns the nameservers listed in the attribute, or the authoritative nameservers of the zone when none are
+func propagationNameservers(ctx context.Context, client dns.DNS, d *schema.ResourceData, key, zone string) ([]string, error) {
+	resolvers, err := tf.GetListValue(key, d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if len(resolvers) == 0 {
+		return zoneNameservers(ctx, client, zone)
+	}
+	nameservers := make([]string, 0, len(resolvers))
+	for _, resolver := range resolvers {
+		nameservers = append(nameservers, resolver.(string))
+	}
+	return nameservers, nil
+}
+
+// zoneNameservers returns the authoritative nameservers of the zone, as listed by the authorities of its contract
+func zoneNameservers(ctx context.Context, client dns.DNS, zone string) ([]string, error) {
+	zoneResp, err := client.GetZone(ctx, dns.GetZoneRequest{Zone: zone})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zone %s: %w", zone, err)
+	}
+	nameservers, err := client.GetNameServerRecordList(ctx, dns.GetNameServerRecordListRequest{
+		ContractIDs: zoneResp.ContractID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorities of contract %s: %w", zoneResp.ContractID, err)
+	}
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no authorities found for contract %s", zoneResp.ContractID)
+	}
+	sort.Strings(nameservers)
+	return nameservers, nil
+}
+
+// waitForPropagation queries every nameserver until all of them return the expected rdata for the name and record type
+func waitForPropagation(ctx context.Context, nameservers []string, name, recordType string, expected []string, logger log.Interface) error {
+	qtype, ok := propagationRecordTypes[recordType]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrPropagationRecordType, recordType)
+	}
+	want := make([]string, 0, len(expected))
+	for _, rdata := range expected {
+		want = append(want, normalizePropagationRdata(recordType, rdata))
+	}
+	sort.Strings(want)
+
+	for {
+		var pending []string
+		for _, nameserver := range nameservers {
+			got, err := queryNameserver(ctx, nameserver, name, qtype)
+			if err != nil {
+				logger.Debugf("Query of %s failed: %s", nameserver, err)
+				pending = append(pending, nameserver)
+				continue
+			}
+			if !equalStrings(got, want) {
+				logger.Debugf("Nameserver %s returned %v, expected %v", nameserver, got, want)
+				pending = append(pending, nameserver)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(tf.MaxDuration(propagationPollInterval, propagationPollMinimum)):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w: %s %s is not served by %s", ErrPropagationTimeout, name, recordType, strings.Join(pending, ", "))
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// queryNameserver asks the nameserver, without recursion, for the records of the name and type
+// and returns their rdata in the normalized presentation format, sorted
+func queryNameserver(ctx context.Context, nameserver, name string, qtype dnsmessage.Type) ([]string, error) {
+	qname, err := dnsmessage.NewName(fqdn(name))
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrNameserverQuery, nameserver, err)
+	}
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		nameserver = net.JoinHostPort(nameserver, "53")
+	}
+
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: uint16(rand.Intn(1 << 16))},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	resp, err := exchange(ctx, "udp", nameserver, query)
+	if err == nil && resp.Truncated {
+		resp, err = exchange(ctx, "tcp", nameserver, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrNameserverQuery, nameserver, err)
+	}
+	switch resp.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return []string{}, nil
+	default:
+		return nil, fmt.Errorf("%w %s: response code %s", ErrNameserverQuery, nameserver, resp.RCode)
+	}
+
+	rdata := make([]string, 0, len(resp.Answers))
+	for _, answer := range resp.Answers {
+		if answer.Header.Type != qtype || !strings.EqualFold(answer.Header.Name.String(), qname.String()) {
+			continue
+		}
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			rdata = append(rdata, netip.AddrFrom4(body.A).String())
+		case *dnsmessage.AAAAResource:
+			rdata = append(rdata, netip.AddrFrom16(body.AAAA).String())
+		case *dnsmessage.CNAMEResource:
+			rdata = append(rdata, strings.ToLower(body.CNAME.String()))
+		case *dnsmessage.NSResource:
+			rdata = append(rdata, strings.ToLower(body.NS.String()))
+		case *dnsmessage.PTRResource:
+			rdata = append(rdata, strings.ToLower(body.PTR.String()))
+		case *dnsmessage.MXResource:
+			rdata = append(rdata, fmt.Sprintf("%d %s", body.Pref, strings.ToLower(body.MX.String())))
+		case *dnsmessage.SRVResource:
+			rdata = append(rdata, fmt.Sprintf("%d %d %d %s", body.Priority, body.Weight, body.Port, strings.ToLower(body.Target.String())))
+		case *dnsmessage.TXTResource:
+			rdata = append(rdata, strings.Join(body.TXT, ""))
+		}
+	}
+	sort.Strings(rdata)
+	return rdata, nil
+}
+
+// exchange sends the query to the nameserver over the network, udp or tcp, and returns the response
+func exchange(ctx context.Context, network, nameserver string, query dnsmessage.Message) (*dnsmessage.Message, error) {
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, propagationQueryTimeout)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf []byte
+	if network == "tcp" {
+		// messages over tcp are prefixed with their length
+		packed = append(binary.BigEndian.AppendUint16(nil, uint16(len(packed))), packed...)
+		if _, err := conn.Write(packed); err != nil {
+			return nil, err
+		}
+		length := make([]byte, 2)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, binary.BigEndian.Uint16(length))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(packed); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, 65535)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[:n]
+	}
+
+	var resp dnsmessage.Message
+	if err := resp.Unpack(buf); err != nil {
+		return nil, err
+	}
+	if resp.ID != query.ID {
+		return nil, fmt.Errorf("response id %d does not match query id %d", resp.ID, query.ID)
+	}
+	return &resp, nil
+}
+
+// normalizePropagationRdata converts the rdata of a record target to the format returned by queryNameserver
+func normalizePropagationRdata(recordType, rdata string) string {
+	fields := strings.Fields(rdata)
+	switch recordType {
+	case RRTypeA, RRTypeAaaa:
+		if addr, err := netip.ParseAddr(rdata); err == nil {
+			return addr.Unmap().String()
+		}
+	case RRTypeCname, RRTypeNs, RRTypePtr:
+		return strings.ToLower(fqdn(rdata))
+	case RRTypeMx:
+		if len(fields) == 2 {
+			return fmt.Sprintf("%s %s", fields[0], strings.ToLower(fqdn(fields[1])))
+		}
+	case RRTypeSrv:
+		if len(fields) == 4 {
+			return fmt.Sprintf("%s %s %s %s", fields[0], fields[1], fields[2], strings.ToLower(fqdn(fields[3])))
+		}
+	case RRTypeTxt:
+		entries, err := splitZoneFile(rdata)
+		if err != nil || len(entries) != 1 {
+			return rdata
+		}
+		var txt strings.Builder
+		for _, token := range entries[0].tokens {
+			txt.WriteString(strings.TrimSuffix(strings.TrimPrefix(token, `"`), `"`))
+		}
+		return txt.String()
+	}
+	return rdata
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,259 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testNameserver is a local stand-in of an authoritative nameserver, answering over udp and tcp on the same port
+type testNameserver struct {
+	addr string
+
+	mu        sync.Mutex
+	records   map[string][]dnsmessage.Resource
+	truncated bool
+	queries   int
+}
+
+func newTestNameserver(t *testing.T) *testNameserver {
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	tcp, err := net.Listen("tcp", udp.LocalAddr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = udp.Close()
+		_ = tcp.Close()
+	})
+
+	ns := &testNameserver{addr: udp.LocalAddr().String(), records: map[string][]dnsmessage.Resource{}}
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := udp.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp, ok := ns.answer(buf[:n], true); ok {
+				_, _ = udp.WriteTo(resp, addr)
+			}
+		}
+	}()
+	go func() {
+		for {
+			conn, err := tcp.Accept()
+			if err != nil {
+				return
+			}
+			length := make([]byte, 2)
+			if _, err := io.ReadFull(conn, length); err == nil {
+				query := make([]byte, binary.BigEndian.Uint16(length))
+				if _, err := io.ReadFull(conn, query); err == nil {
+					if resp, ok := ns.answer(query, false); ok {
+						_, _ = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(resp))), resp...))
+					}
+				}
+			}
+			_ = conn.Close()
+		}
+	}()
+	return ns
+}
+
+// serve replaces the records returned for the name and type
+func (ns *testNameserver) serve(name string, qtype dnsmessage.Type, bodies ...dnsmessage.ResourceBody) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	var resources []dnsmessage.Resource
+	for _, body := range bodies {
+		resources = append(resources, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET, TTL: 300},
+			Body:   body,
+		})
+	}
+	ns.records[name+"/"+qtype.String()] = resources
+}
+
+func (ns *testNameserver) answer(query []byte, udp bool) ([]byte, bool) {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(query); err != nil || len(msg.Questions) != 1 {
+		return nil, false
+	}
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	ns.queries++
+
+	question := msg.Questions[0]
+	resp := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: msg.ID, Response: true, Authoritative: true},
+		Questions: msg.Questions,
+	}
+	answers, ok := ns.records[question.Name.String()+"/"+question.Type.String()]
+	switch {
+	case !ok:
+		resp.RCode = dnsmessage.RCodeNameError
+	case udp && ns.truncated:
+		resp.Truncated = true
+	default:
+		resp.Answers = answers
+	}
+	packed, err := resp.Pack()
+	return packed, err == nil
+}
+
+func TestQueryNameserver(t *testing.T) {
+	ns := newTestNameserver(t)
+	ns.serve("www.example.com.", dnsmessage.TypeA,
+		&dnsmessage.AResource{A: netip.MustParseAddr("192.0.2.2").As4()},
+		&dnsmessage.AResource{A: netip.MustParseAddr("192.0.2.1").As4()})
+	ns.serve("example.com.", dnsmessage.TypeMX, &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("Mail.Example.com.")})
+	ns.serve("_sip._tcp.example.com.", dnsmessage.TypeSRV,
+		&dnsmessage.SRVResource{Priority: 10, Weight: 60, Port: 5060, Target: dnsmessage.MustNewName("sip.example.com.")})
+	ns.serve("example.com.", dnsmessage.TypeTXT, &dnsmessage.TXTResource{TXT: []string{"v=spf1 ", "-all"}})
+
+	tests := map[string]struct {
+		name      string
+		qtype     dnsmessage.Type
+		truncated bool
+		expected  []string
+	}{
+		"A records are sorted": {
+			name:     "www.example.com",
+			qtype:    dnsmessage.TypeA,
+			expected: []string{"192.0.2.1", "192.0.2.2"},
+		},
+		"MX exchange is lowercased": {
+			name:     "example.com",
+			qtype:    dnsmessage.TypeMX,
+			expected: []string{"10 mail.example.com."},
+		},
+		"SRV": {
+			name:     "_sip._tcp.example.com",
+			qtype:    dnsmessage.TypeSRV,
+			expected: []string{"10 60 5060 sip.example.com."},
+		},
+		"TXT strings are joined": {
+			name:     "example.com",
+			qtype:    dnsmessage.TypeTXT,
+			expected: []string{"v=spf1 -all"},
+		},
+		"truncated response is retried over tcp": {
+			name:      "www.example.com",
+			qtype:     dnsmessage.TypeA,
+			truncated: true,
+			expected:  []string{"192.0.2.1", "192.0.2.2"},
+		},
+		"nxdomain": {
+			name:     "missing.example.com",
+			qtype:    dnsmessage.TypeA,
+			expected: []string{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ns.mu.Lock()
+			ns.truncated = test.truncated
+			ns.mu.Unlock()
+
+			rdata, err := queryNameserver(context.Background(), ns.addr, test.name, test.qtype)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, rdata)
+		})
+	}
+
+	t.Run("unreachable nameserver", func(t *testing.T) {
+		propagationQueryTimeout = 50 * time.Millisecond
+		defer func() { propagationQueryTimeout = 5 * time.Second }()
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+		_, err = queryNameserver(context.Background(), conn.LocalAddr().String(), "www.example.com", dnsmessage.TypeA)
+		assert.True(t, errors.Is(err, ErrNameserverQuery), err)
+	})
+}
+
+func TestNormalizePropagationRdata(t *testing.T) {
+	tests := []struct {
+		recordType string
+		rdata      string
+		expected   string
+	}{
+		{RRTypeA, "192.0.2.1", "192.0.2.1"},
+		{RRTypeAaaa, "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{RRTypeCname, "Target.Example.com", "target.example.com."},
+		{RRTypeMx, "10 Mail.example.com.", "10 mail.example.com."},
+		{RRTypeSrv, "10 60 5060 sip.example.com", "10 60 5060 sip.example.com."},
+		{RRTypeTxt, `"v=spf1 " "-all"`, "v=spf1 -all"},
+		{RRTypeTxt, "unquoted", "unquoted"},
+	}
+	for _, test := range tests {
+		t.Run(test.recordType+" "+test.rdata, func(t *testing.T) {
+			assert.Equal(t, test.expected, normalizePropagationRdata(test.recordType, test.rdata))
+		})
+	}
+}
+
+func TestWaitForPropagation(t *testing.T) {
+	propagationPollMinimum, propagationPollInterval = time.Millisecond, time.Millisecond
+	logger := log.Log
+
+	t.Run("records are served by every nameserver", func(t *testing.T) {
+		served, pending := newTestNameserver(t), newTestNameserver(t)
+		served.serve("www.example.com.", dnsmessage.TypeCNAME, &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("target.example.com.")})
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			pending.serve("www.example.com.", dnsmessage.TypeCNAME, &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("target.example.com.")})
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		err := waitForPropagation(ctx, []string{served.addr, pending.addr}, "www.example.com", RRTypeCname, []string{"target.example.com"}, logger)
+		require.NoError(t, err)
+		pending.mu.Lock()
+		defer pending.mu.Unlock()
+		assert.Greater(t, pending.queries, 1)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ns := newTestNameserver(t)
+		ns.serve("www.example.com.", dnsmessage.TypeA, &dnsmessage.AResource{A: netip.MustParseAddr("192.0.2.1").As4()})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := waitForPropagation(ctx, []string{ns.addr}, "www.example.com", RRTypeA, []string{"192.0.2.1", "192.0.2.2"}, logger)
+		assert.True(t, errors.Is(err, ErrPropagationTimeout), err)
+		assert.Contains(t, err.Error(), ns.addr)
+	})
+
+	t.Run("unsupported record type", func(t *testing.T) {
+		err := waitForPropagation(context.Background(), []string{"127.0.0.1"}, "example.com", RRTypeCaa, nil, logger)
+		assert.True(t, errors.Is(err, ErrPropagationRecordType), err)
+	})
+}
+
+func TestZoneNameservers(t *testing.T) {
+	client := &dns.Mock{}
+	client.On("GetZone", mock.Anything, dns.GetZoneRequest{Zone: "example.com"}).
+		Return(&dns.GetZoneResponse{Zone: "example.com", ContractID: "1-ABCD"}, nil).Once()
+	client.On("GetNameServerRecordList", mock.Anything, dns.GetNameServerRecordListRequest{ContractIDs: "1-ABCD"}).
+		Return([]string{"a9-65.akam.net", "a1-10.akam.net"}, nil).Once()
+
+	nameservers, err := zoneNameservers(context.Background(), client, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1-10.akam.net", "a9-65.akam.net"}, nameservers)
+	client.AssertExpectations(t)
+}
@@ -25,6 +25,12 @@ var (
 	once sync.Once
 
 	inst *Subprovider
+
+	changeListClient changeLists
+
+	dnssecClient dnssecKeys
+
+	zoneTransferClient zoneTransfers
 )
 
 var _ subprovider.Subprovider = &Subprovider{}
@@ -52,19 +58,53 @@ func (p *Subprovider) Client(meta meta.Meta) dns.DNS {
 	return dns.Client(meta.Session())
 }
 
+// changeListsClientFor returns the client of the changelist operations missing in the edgegrid client
+func changeListsClientFor(meta meta.Meta) changeLists {
+	if changeListClient != nil {
+		return changeListClient
+	}
+	return &changeListsClient{session: meta.Session()}
+}
+
+// dnssecKeysClientFor returns the client of the DNSSEC key operations missing in the edgegrid client
+func dnssecKeysClientFor(meta meta.Meta) dnssecKeys {
+	if dnssecClient != nil {
+		return dnssecClient
+	}
+	return &dnssecKeysClient{session: meta.Session()}
+}
+
+// zoneTransfersClientFor returns the client of the zone transfer operations missing in the edgegrid client
+func zoneTransfersClientFor(meta meta.Meta) zoneTransfers {
+	if zoneTransferClient != nil {
+		return zoneTransferClient
+	}
+	return &zoneTransfersClient{session: meta.Session()}
+}
+
 // SDKResources returns the DNS resources implemented using terraform-plugin-sdk
 func (p *Subprovider) SDKResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"akamai_dns_zone":   resourceDNSv2Zone(),
-		"akamai_dns_record": resourceDNSv2Record(),
+		"akamai_dns_zone":                 resourceDNSv2Zone(),
+		"akamai_dns_bulk_zones":           resourceDNSBulkZones(),
+		"akamai_dns_zone_file":            resourceDNSZoneFile(),
+		"akamai_dns_zone_dnssec_rollover": resourceDNSZoneDNSSecRollover(),
+		"akamai_dns_record":               resourceDNSv2Record(),
+		"akamai_dns_recordsets":           resourceDNSRecordSets(),
+		"akamai_dns_changelist_submit":    resourceDNSChangeListSubmit(),
+		"akamai_dns_tsig_key":             resourceDNSTSIGKey(),
 	}
 }
 
 // SDKDataSources returns the DNS data sources implemented using terraform-plugin-sdk
 func (p *Subprovider) SDKDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"akamai_authorities_set": dataSourceAuthoritiesSet(),
-		"akamai_dns_record_set":  dataSourceDNSRecordSet(),
+		"akamai_authorities_set":          dataSourceAuthoritiesSet(),
+		"akamai_dns_record_propagation":   dataSourceDNSRecordPropagation(),
+		"akamai_dns_record_set":           dataSourceDNSRecordSet(),
+		"akamai_dns_zone_file":            dataSourceDNSZoneFile(),
+		"akamai_dns_zone_records":         dataSourceDNSZoneRecords(),
+		"akamai_dns_zone_transfer_status": dataSourceDNSZoneTransferStatus(),
 	}
 }
 
@@ -77,5 +117,6 @@ func (p *Subprovider) FrameworkResources() []func() resource.Resource {
 func (p *Subprovider) FrameworkDataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewZoneDNSSecStatusDataSource,
+		NewZoneDNSSecKeysDataSource,
 	}
 }
@@ -29,6 +29,39 @@ func useClient(client dns.DNS, f func()) {
 	f()
 }
 
+// useChangeLists swaps out the changelist client for the duration of the given func
+func useChangeLists(client changeLists, f func()) {
+	orig := changeListClient
+	changeListClient = client
+	defer func() {
+		changeListClient = orig
+	}()
+
+	f()
+}
+
+// useDNSSecKeys swaps out the DNSSEC key client for the duration of the given func
+func useDNSSecKeys(client dnssecKeys, f func()) {
+	orig := dnssecClient
+	dnssecClient = client
+	defer func() {
+		dnssecClient = orig
+	}()
+
+	f()
+}
+
+// useZoneTransfers swaps out the zone transfer client for the duration of the given func
+func useZoneTransfers(client zoneTransfers, f func()) {
+	orig := zoneTransferClient
+	zoneTransferClient = client
+	defer func() {
+		zoneTransferClient = orig
+	}()
+
+	f()
+}
+
 type data struct {
 	data map[string]interface{}
 }
@@ -0,0 +1,120 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+)
+
+// Default retry settings of zone writes, used unless the provider configures its own
+const (
+	opRetryCount   = 5
+	opRetryWaitMin = 100 * time.Millisecond
+	opRetryWaitMax = 5 * time.Second
+)
+
+var (
+	// ErrRecordConflict is returned when a write is rejected because of a concurrent modification of the zone
+	ErrRecordConflict = errors.New("concurrent modification of the zone")
+	// ErrSOASerialNotIncremented is returned when a write is rejected because the SOA serial number of the zone was not incremented
+	ErrSOASerialNotIncremented = errors.New("SOA serial number must be incremented")
+
+	// zoneLocks serializes writes to the same zone
+	zoneLocks sync.Map
+)
+
+// recordRetry controls how zone writes rejected because of concurrent modifications are retried
+type recordRetry struct {
+	max     int
+	waitMin time.Duration
+	waitMax time.Duration
+}
+
+// zoneLock returns the lock serializing writes to the zone
+func zoneLock(zone string) *sync.Mutex {
+	lock, _ := zoneLocks.LoadOrStore(zone, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// recordError wraps an API error of a zone write with the typed error of its cause
+func recordError(err error) error {
+	var apiError *dns.Error
+	if !errors.As(err, &apiError) {
+		return err
+	}
+	switch {
+	case apiError.StatusCode == http.StatusConflict:
+		return fmt.Errorf("%w: %w", ErrRecordConflict, err)
+	case isSOASerialError(apiError):
+		return fmt.Errorf("%w: %w", ErrSOASerialNotIncremented, err)
+	}
+	return err
+}
+
+// isSOASerialError reports whether the API rejected a write because the SOA serial number was not incremented.
+// The API reports it as a validation error without a problem type of its own, so the title and detail
+// of 400 and 422 responses are matched against the message of the API instead.
+func isSOASerialError(apiError *dns.Error) bool {
+	if apiError.StatusCode != http.StatusBadRequest && apiError.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, field := range []string{apiError.Title, apiError.Detail} {
+		if strings.Contains(strings.ToLower(field), "soa serial number must be incremented") {
+			return true
+		}
+	}
+	return false
+}
+
+// recordRetryFor returns the retry settings of zone writes based on the retry settings of the provider.
+// When the provider disables retries, zone writes are not retried either.
+func recordRetryFor(meta meta.Meta) recordRetry {
+	cfg := meta.RetryConfig()
+	if cfg.Disabled {
+		return recordRetry{}
+	}
+
+	retry := recordRetry{max: opRetryCount, waitMin: opRetryWaitMin, waitMax: opRetryWaitMax}
+	if cfg.Max > 0 {
+		retry.max = cfg.Max
+	}
+	if cfg.WaitMin > 0 {
+		retry.waitMin = cfg.WaitMin
+	}
+	if cfg.WaitMax > 0 {
+		retry.waitMax = cfg.WaitMax
+	}
+	if retry.waitMax < retry.waitMin {
+		retry.waitMax = retry.waitMin
+	}
+	return retry
+}
+
+// backoff returns the wait time before the given retry attempt, doubling from waitMin up to waitMax
+func (r recordRetry) backoff(attempt int) time.Duration {
+	wait := r.waitMin
+	for i := 1; i < attempt && wait < r.waitMax; i++ {
+		wait *= 2
+	}
+	if wait > r.waitMax {
+		return r.waitMax
+	}
+	return wait
+}
+
+// wait blocks for the backoff of the given retry attempt or until the context is done
+func (r recordRetry) wait(ctx context.Context, attempt int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(r.backoff(attempt)):
+		return nil
+	}
+}
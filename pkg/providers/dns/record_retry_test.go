@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testMeta(t *testing.T, retry meta.RetryConfig) meta.Meta {
+	m, err := meta.New(session.Must(session.New()), hclog.NewNullLogger(), "")
+	require.NoError(t, err)
+	m.SetRetryConfig(retry)
+	return m
+}
+
+func TestRecordError(t *testing.T) {
+	tests := map[string]struct {
+		err       error
+		withError error
+	}{
+		"conflict": {
+			err:       &dns.Error{StatusCode: http.StatusConflict, Title: "Conflict"},
+			withError: ErrRecordConflict,
+		},
+		"soa serial in detail": {
+			err:       &dns.Error{StatusCode: http.StatusBadRequest, Title: "Bad Request", Detail: "SOA serial number must be incremented"},
+			withError: ErrSOASerialNotIncremented,
+		},
+		"soa serial in title of unprocessable entity": {
+			err:       &dns.Error{StatusCode: http.StatusUnprocessableEntity, Title: "SOA serial number must be incremented"},
+			withError: ErrSOASerialNotIncremented,
+		},
+		"soa serial message of server error": {
+			err: &dns.Error{StatusCode: http.StatusInternalServerError, Detail: "SOA serial number must be incremented"},
+		},
+		"other api error": {
+			err: &dns.Error{StatusCode: http.StatusBadRequest, Title: "Bad Request", Detail: "Invalid rdata"},
+		},
+		"not an api error": {
+			err: errors.New("SOA serial number must be incremented"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := recordError(test.err)
+			assert.True(t, errors.Is(err, test.err))
+			assert.False(t, errors.Is(err, ErrRecordConflict) && test.withError != ErrRecordConflict)
+			assert.False(t, errors.Is(err, ErrSOASerialNotIncremented) && test.withError != ErrSOASerialNotIncremented)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError))
+			}
+		})
+	}
+}
+
+func TestRecordRetryFor(t *testing.T) {
+	tests := map[string]struct {
+		config   meta.RetryConfig
+		expected recordRetry
+	}{
+		"defaults": {
+			expected: recordRetry{max: opRetryCount, waitMin: opRetryWaitMin, waitMax: opRetryWaitMax},
+		},
+		"provider settings": {
+			config:   meta.RetryConfig{Max: 10, WaitMin: time.Second, WaitMax: 30 * time.Second},
+			expected: recordRetry{max: 10, waitMin: time.Second, waitMax: 30 * time.Second},
+		},
+		"wait min above default wait max": {
+			config:   meta.RetryConfig{WaitMin: 10 * time.Second},
+			expected: recordRetry{max: opRetryCount, waitMin: 10 * time.Second, waitMax: 10 * time.Second},
+		},
+		"retries disabled": {
+			config:   meta.RetryConfig{Disabled: true, Max: 10},
+			expected: recordRetry{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, recordRetryFor(testMeta(t, test.config)))
+		})
+	}
+}
+
+func TestRecordRetryBackoff(t *testing.T) {
+	retry := recordRetry{max: 5, waitMin: 100 * time.Millisecond, waitMax: time.Second}
+	var waits []time.Duration
+	for attempt := 1; attempt <= retry.max; attempt++ {
+		waits = append(waits, retry.backoff(attempt))
+	}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+	}, waits)
+}
+
+func TestExecuteRecordFunctionRetries(t *testing.T) {
+	record := &dns.RecordBody{Name: "www.example.com", RecordType: "A", TTL: 300, Target: []string{"192.0.2.1"}}
+	request := dns.CreateRecordRequest{Zone: "example.com", Record: record, RecLock: []bool{false}}
+	conflict := &dns.Error{StatusCode: http.StatusConflict, Title: "Conflict"}
+
+	tests := map[string]struct {
+		init      func(*dns.Mock)
+		withError error
+	}{
+		"conflict is retried": {
+			init: func(client *dns.Mock) {
+				client.On("CreateRecord", mock.Anything, request).Return(conflict).Twice()
+				client.On("CreateRecord", mock.Anything, request).Return(nil).Once()
+			},
+		},
+		"retries are exhausted": {
+			init: func(client *dns.Mock) {
+				client.On("CreateRecord", mock.Anything, request).Return(conflict).Times(3)
+			},
+			withError: ErrRecordConflict,
+		},
+		"other errors are not retried": {
+			init: func(client *dns.Mock) {
+				client.On("CreateRecord", mock.Anything, request).Return(&dns.Error{StatusCode: http.StatusBadRequest}).Once()
+			},
+			withError: &dns.Error{StatusCode: http.StatusBadRequest},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &dns.Mock{}
+			test.init(client)
+			m := testMeta(t, meta.RetryConfig{Max: 2, WaitMin: time.Millisecond})
+			d := schema.TestResourceDataRaw(t, getResourceDNSRecordSchema(), map[string]interface{}{
+				"zone":       "example.com",
+				"name":       "www.example.com",
+				"recordtype": "A",
+			})
+
+			useClient(client, func() {
+				err := executeRecordFunction(context.Background(), m, "CREATE", d, "Create", record, "example.com", "www.example.com", "A", m.Log(), []bool{false})
+				if test.withError != nil {
+					assert.True(t, errors.Is(err, test.withError), err)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,509 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// bulkZonesBatchSize is the default number of zones submitted in a single bulk create request
+	bulkZonesBatchSize = 100
+	// bulkZonesMaxBatchSize is the maximum number of zones the API accepts in a single bulk create request
+	bulkZonesMaxBatchSize = 1000
+	// zoneListPageSize is the number of zones read in a single request
+	zoneListPageSize = 100
+)
+
+var (
+	// bulkZonesPollMinimum is the minimum interval between status checks of a bulk create request
+	bulkZonesPollMinimum = 5 * time.Second
+	// bulkZonesPollInterval is the interval between status checks of a bulk create request
+	bulkZonesPollInterval = bulkZonesPollMinimum
+	// bulkZonesTimeout is the default time to wait for bulk create requests to complete
+	bulkZonesTimeout = 30 * time.Minute
+
+	// ErrBulkZonesTimeout is returned when a bulk create request does not complete in time
+	ErrBulkZonesTimeout = errors.New("timed out waiting for bulk zone create request")
+	// ErrBulkZonesFailed is returned when some zones of a bulk create request were not created
+	ErrBulkZonesFailed = errors.New("bulk zone create failed")
+)
+
+type bulkZonesResult struct {
+	// Created lists the zones created by all requests
+	Created []string
+	// Failed lists the zones which were not created by all requests, with the reasons
+	Failed []dns.BulkFailedZone
+	// Requests lists the status of the submitted requests, in the order of submission
+	Requests []dns.GetBulkZoneCreateStatusResponse
+}
+
+func resourceDNSBulkZones() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSBulkZonesCreate,
+		ReadContext:   resourceDNSBulkZonesRead,
+		UpdateContext: resourceDNSBulkZonesUpdate,
+		DeleteContext: resourceDNSBulkZonesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: &bulkZonesTimeout,
+			Update: &bulkZonesTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"contract": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tf.FieldPrefixSuppress("ctr_"),
+				Description:      "Contract the zones are created in",
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tf.FieldPrefixSuppress("grp_"),
+				Description:      "Group the zones are created in",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateZoneType,
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				Description: "Type of all the zones, PRIMARY, SECONDARY or ALIAS",
+			},
+			"zones": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Names of the zones. Zones removed from the list are no longer managed, but they are not deleted",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Zone the ALIAS zones point at",
+			},
+			"masters": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Master servers of the SECONDARY zones",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Managed by Terraform",
+				Description: "Comment of the zones",
+			},
+			"batch_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          bulkZonesBatchSize,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, bulkZonesMaxBatchSize)),
+				Description:      "Number of zones submitted in a single bulk create request",
+			},
+			"requests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Bulk create requests submitted by the last apply which created zones",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the bulk create request",
+						},
+						"zones_submitted": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of zones submitted in the request",
+						},
+						"success_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of zones created by the request",
+						},
+						"failure_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of zones which failed to be created",
+						},
+						"expiration_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time after which the status of the request is no longer available",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSBulkZonesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSBulkZonesCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	if err := checkBulkZones(d); err != nil {
+		return diag.FromErr(err)
+	}
+	zoneSet, err := tf.GetSetValue("zones", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zones := tf.SetToStringSlice(zoneSet)
+	sort.Strings(zones)
+	logger.WithField("zones", len(zones)).Info("Bulk Zones Create")
+
+	result, err := createBulkZonesFromData(ctx, inst.Client(meta), d, zones, logger)
+	if result == nil {
+		return diag.FromErr(err)
+	}
+	if len(result.Created) > 0 {
+		d.SetId(result.Requests[0].RequestID)
+	}
+	diags := setBulkZonesResult(ctx, d, m, nil, result)
+	if err != nil {
+		diags = append(diag.FromErr(err), diags...)
+	}
+	return diags
+}
+
+func resourceDNSBulkZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSBulkZonesRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	contract, err := tf.GetStringValue("contract", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneType, err := tf.GetStringValue("type", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneSet, err := tf.GetSetValue("zones", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	existing, err := listZones(ctx, inst.Client(meta), strings.TrimPrefix(contract, "ctr_"), zoneType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attrs := make(map[string]interface{})
+	zones := make([]string, 0, zoneSet.Len())
+	for _, name := range tf.SetToStringSlice(zoneSet) {
+		zone, ok := existing[strings.ToLower(name)]
+		if !ok {
+			logger.WithField("zone", name).Warn("Zone not found, removing from state")
+			continue
+		}
+		zones = append(zones, name)
+		// the attributes are shared by all the zones, so a zone which differs is reported as the value
+		// of the attribute and updated together with the others on the next apply
+		if zone.Comment != d.Get("comment").(string) {
+			attrs["comment"] = zone.Comment
+		}
+		if !strings.EqualFold(strings.TrimSuffix(zone.Target, "."), strings.TrimSuffix(d.Get("target").(string), ".")) {
+			attrs["target"] = zone.Target
+		}
+		if !equalStringSets(zone.Masters, tf.SetToStringSlice(d.Get("masters").(*schema.Set))) {
+			attrs["masters"] = zone.Masters
+		}
+	}
+	if len(zones) == 0 {
+		logger.Warn("None of the zones were found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	attrs["zones"] = zones
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDNSBulkZonesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSBulkZonesUpdate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+
+	if err := checkBulkZones(d); err != nil {
+		return diag.FromErr(err)
+	}
+	oldZones, newZones := d.GetChange("zones")
+	kept := tf.SetToStringSlice(oldZones.(*schema.Set).Intersection(newZones.(*schema.Set)))
+	added := tf.SetToStringSlice(newZones.(*schema.Set).Difference(oldZones.(*schema.Set)))
+	removed := tf.SetToStringSlice(oldZones.(*schema.Set).Difference(newZones.(*schema.Set)))
+	sort.Strings(kept)
+	sort.Strings(added)
+	logger.WithFields(log.Fields{"added": len(added), "removed": removed}).Info("Bulk Zones Update")
+	if len(removed) > 0 {
+		logger.WithField("zones", removed).Warn("Zones removed from the resource are left unchanged")
+	}
+
+	if d.HasChanges("comment", "target", "masters") {
+		for _, zone := range kept {
+			if err := updateBulkZone(ctx, client, d, zone); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	result := &bulkZonesResult{}
+	var err error
+	if len(added) > 0 {
+		if result, err = createBulkZonesFromData(ctx, client, d, added, logger); result == nil {
+			return diag.FromErr(err)
+		}
+	}
+	diags := setBulkZonesResult(ctx, d, m, kept, result)
+	if err != nil {
+		diags = append(diag.FromErr(err), diags...)
+	}
+	return diags
+}
+
+func resourceDNSBulkZonesDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSBulkZonesDelete")
+	logger.WithField("zones", d.Get("zones").(*schema.Set).Len()).Info("Bulk Zones Delete")
+	// Ignore for Unit test Lifecycle
+	if _, ok := os.LookupEnv("DNS_ZONE_SKIP_DELETE"); ok {
+		logger.Info("DNS Bulk Zones delete: intentionally skipping")
+		return nil
+	}
+	logger.Warn("DNS Zone deletion not allowed")
+
+	// No ZONE delete operation permitted.
+	return diag.Errorf("DNS zone deletion is not supported via this sub provider")
+}
+
+// checkBulkZones verifies the attributes of the resource needed by the type of the zones
+func checkBulkZones(d *schema.ResourceData) error {
+	zoneType := strings.ToUpper(d.Get("type").(string))
+	masters := d.Get("masters").(*schema.Set)
+	target := d.Get("target").(string)
+	if zoneType == "SECONDARY" && masters.Len() == 0 {
+		return fmt.Errorf("masters list must be populated for %s zones", zoneType)
+	}
+	if zoneType != "SECONDARY" && masters.Len() > 0 {
+		return fmt.Errorf("masters list can not be populated for %s zones", zoneType)
+	}
+	if zoneType == "ALIAS" && target == "" {
+		return fmt.Errorf("target must be populated for %s zones", zoneType)
+	}
+	if zoneType != "ALIAS" && target != "" {
+		return fmt.Errorf("target can not be populated for %s zones", zoneType)
+	}
+	return nil
+}
+
+// setBulkZonesResult stores the zones kept from before and those created by the requests, then reads the resource.
+// Zones which failed to be created are reported as an error, but the created ones are kept in the state.
+func setBulkZonesResult(ctx context.Context, d *schema.ResourceData, m interface{}, kept []string, result *bulkZonesResult) diag.Diagnostics {
+	zones := make([]string, 0, len(kept)+len(result.Created))
+	zones = append(zones, kept...)
+	zones = append(zones, result.Created...)
+	attrs := map[string]interface{}{"zones": zones}
+	if len(result.Requests) > 0 {
+		requests := make([]interface{}, 0, len(result.Requests))
+		for _, request := range result.Requests {
+			requests = append(requests, map[string]interface{}{
+				"request_id":      request.RequestID,
+				"zones_submitted": request.ZonesSubmitted,
+				"success_count":   request.SuccessCount,
+				"failure_count":   request.FailureCount,
+				"expiration_date": request.ExpirationDate,
+			})
+		}
+		attrs["requests"] = requests
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	if len(result.Failed) > 0 {
+		reasons := make([]string, 0, len(result.Failed))
+		for _, failed := range result.Failed {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", failed.Zone, failed.FailureReason))
+		}
+		diags = diag.Errorf("%s: %d zones were not created:\n%s", ErrBulkZonesFailed, len(result.Failed), strings.Join(reasons, "\n"))
+	}
+	if d.Id() == "" {
+		return diags
+	}
+	return append(diags, resourceDNSBulkZonesRead(ctx, d, m)...)
+}
+
+// createBulkZonesFromData creates the zones with the attributes of the resource
+func createBulkZonesFromData(ctx context.Context, client dns.DNS, d *schema.ResourceData, zones []string, logger log.Interface) (*bulkZonesResult, error) {
+	contract, err := tf.GetStringValue("contract", d)
+	if err != nil {
+		return nil, err
+	}
+	group, err := tf.GetStringValue("group", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	batchSize, err := tf.GetIntValue("batch_size", d)
+	if err != nil {
+		return nil, err
+	}
+	query := dns.ZoneQueryString{Contract: strings.TrimPrefix(contract, "ctr_"), Group: strings.TrimPrefix(group, "grp_")}
+
+	zoneCreates := make([]dns.ZoneCreate, 0, len(zones))
+	for _, zone := range zones {
+		zoneCreate := dns.ZoneCreate{Zone: zone, Type: strings.ToUpper(d.Get("type").(string))}
+		applyBulkZoneAttributes(d, &zoneCreate)
+		zoneCreates = append(zoneCreates, zoneCreate)
+	}
+	return createBulkZones(ctx, client, query, zoneCreates, batchSize, logger)
+}
+
+// applyBulkZoneAttributes sets the attributes shared by all the zones of the resource on the zone
+func applyBulkZoneAttributes(d *schema.ResourceData, zone *dns.ZoneCreate) {
+	zone.Comment = d.Get("comment").(string)
+	zone.Target = d.Get("target").(string)
+	zone.Masters = tf.SetToStringSlice(d.Get("masters").(*schema.Set))
+	sort.Strings(zone.Masters)
+}
+
+// updateBulkZone updates the attributes shared by all the zones of the resource on an existing zone
+func updateBulkZone(ctx context.Context, client dns.DNS, d *schema.ResourceData, name string) error {
+	zone, err := client.GetZone(ctx, dns.GetZoneRequest{Zone: name})
+	if err != nil {
+		return fmt.Errorf("failed to read zone %s: %w", name, err)
+	}
+	zoneCreate := &dns.ZoneCreate{
+		Zone:                  zone.Zone,
+		Type:                  zone.Type,
+		SignAndServe:          zone.SignAndServe,
+		SignAndServeAlgorithm: zone.SignAndServeAlgorithm,
+		TSIGKey:               zone.TSIGKey,
+		EndCustomerID:         zone.EndCustomerID,
+		ContractID:            zone.ContractID,
+		OutboundZoneTransfer:  zone.OutboundZoneTransfer,
+	}
+	applyBulkZoneAttributes(d, zoneCreate)
+	if err := client.UpdateZone(ctx, dns.UpdateZoneRequest{CreateZone: zoneCreate}); err != nil {
+		return fmt.Errorf("failed to update zone %s: %w", name, err)
+	}
+	return nil
+}
+
+// createBulkZones submits the zones in batches of the given size, one bulk create request at a time,
+// and waits for each request to complete
+func createBulkZones(ctx context.Context, client dns.DNS, query dns.ZoneQueryString, zones []dns.ZoneCreate, batchSize int, logger log.Interface) (*bulkZonesResult, error) {
+	result := &bulkZonesResult{}
+	for start := 0; start < len(zones); start += batchSize {
+		batch := zones[start:min(start+batchSize, len(zones))]
+		resp, err := client.CreateBulkZones(ctx, dns.CreateBulkZonesRequest{
+			BulkZones:       &dns.BulkZonesCreate{Zones: batch},
+			ZoneQueryString: query,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to submit bulk create request of %d zones: %w", len(batch), err)
+		}
+		logger.WithFields(log.Fields{"request_id": resp.RequestID, "zones": len(batch)}).Info("Bulk zone create request submitted")
+
+		status, err := waitForBulkZoneCreate(ctx, client, resp.RequestID)
+		if err != nil {
+			return result, err
+		}
+		result.Requests = append(result.Requests, *status)
+
+		createResult, err := client.GetBulkZoneCreateResult(ctx, dns.GetBulkZoneCreateResultRequest{RequestID: resp.RequestID})
+		if err != nil {
+			return result, fmt.Errorf("failed to read result of bulk create request %s: %w", resp.RequestID, err)
+		}
+		result.Created = append(result.Created, createResult.SuccessfullyCreatedZones...)
+		result.Failed = append(result.Failed, createResult.FailedZones...)
+	}
+	return result, nil
+}
+
+// waitForBulkZoneCreate polls the status of the bulk create request until it completes
+func waitForBulkZoneCreate(ctx context.Context, client dns.DNS, requestID string) (*dns.GetBulkZoneCreateStatusResponse, error) {
+	for {
+		status, err := client.GetBulkZoneCreateStatus(ctx, dns.GetBulkZoneCreateStatusRequest{RequestID: requestID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read status of bulk create request %s: %w", requestID, err)
+		}
+		if status.IsComplete {
+			return status, nil
+		}
+
+		select {
+		case <-time.After(tf.MaxDuration(bulkZonesPollInterval, bulkZonesPollMinimum)):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w %s", ErrBulkZonesTimeout, requestID)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// listZones returns the zones of the given type in the contract, keyed by the lowercase zone name
+func listZones(ctx context.Context, client dns.DNS, contract, zoneType string) (map[string]dns.ZoneResponse, error) {
+	zones := make(map[string]dns.ZoneResponse)
+	for page := 1; ; page++ {
+		resp, err := client.ListZones(ctx, dns.ListZonesRequest{
+			ContractIDs: contract,
+			Types:       strings.ToUpper(zoneType),
+			Page:        page,
+			PageSize:    zoneListPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read page %d of zones of contract %s: %w", page, contract, err)
+		}
+		for _, zone := range resp.Zones {
+			zones[strings.ToLower(zone.Zone)] = zone
+		}
+		if len(resp.Zones) == 0 || resp.Metadata == nil || page*zoneListPageSize >= resp.Metadata.TotalElements {
+			break
+		}
+	}
+	return zones, nil
+}
+
+// equalStringSets reports whether both slices contain the same strings, regardless of order
+func equalStringSets(a, b []string) bool {
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return equalStrings(a, b)
+}
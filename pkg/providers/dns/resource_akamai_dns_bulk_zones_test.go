@@ -0,0 +1,200 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBulkZones(t *testing.T) {
+	bulkZonesPollMinimum, bulkZonesPollInterval = time.Millisecond, time.Millisecond
+	logger := log.Log
+	query := dns.ZoneQueryString{Contract: "1-3CV382", Group: "18432"}
+	zones := []dns.ZoneCreate{
+		{Zone: "a.example.com", Type: "ALIAS", Target: "example.com"},
+		{Zone: "b.example.com", Type: "ALIAS", Target: "example.com"},
+		{Zone: "c.example.com", Type: "ALIAS", Target: "example.com"},
+	}
+
+	t.Run("zones are submitted in batches", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("CreateBulkZones", mock.Anything, dns.CreateBulkZonesRequest{
+			BulkZones: &dns.BulkZonesCreate{Zones: zones[:2]}, ZoneQueryString: query,
+		}).Return(&dns.CreateBulkZonesResponse{RequestID: "req-1"}, nil).Once()
+		client.On("GetBulkZoneCreateStatus", mock.Anything, dns.GetBulkZoneCreateStatusRequest{RequestID: "req-1"}).
+			Return(&dns.GetBulkZoneCreateStatusResponse{RequestID: "req-1", ZonesSubmitted: 2}, nil).Once()
+		client.On("GetBulkZoneCreateStatus", mock.Anything, dns.GetBulkZoneCreateStatusRequest{RequestID: "req-1"}).
+			Return(&dns.GetBulkZoneCreateStatusResponse{RequestID: "req-1", ZonesSubmitted: 2, SuccessCount: 1, FailureCount: 1, IsComplete: true}, nil).Once()
+		client.On("GetBulkZoneCreateResult", mock.Anything, dns.GetBulkZoneCreateResultRequest{RequestID: "req-1"}).
+			Return(&dns.GetBulkZoneCreateResultResponse{
+				RequestID:                "req-1",
+				SuccessfullyCreatedZones: []string{"a.example.com"},
+				FailedZones:              []dns.BulkFailedZone{{Zone: "b.example.com", FailureReason: "ZONE_ALREADY_EXISTS"}},
+			}, nil).Once()
+		client.On("CreateBulkZones", mock.Anything, dns.CreateBulkZonesRequest{
+			BulkZones: &dns.BulkZonesCreate{Zones: zones[2:]}, ZoneQueryString: query,
+		}).Return(&dns.CreateBulkZonesResponse{RequestID: "req-2"}, nil).Once()
+		client.On("GetBulkZoneCreateStatus", mock.Anything, dns.GetBulkZoneCreateStatusRequest{RequestID: "req-2"}).
+			Return(&dns.GetBulkZoneCreateStatusResponse{RequestID: "req-2", ZonesSubmitted: 1, SuccessCount: 1, IsComplete: true}, nil).Once()
+		client.On("GetBulkZoneCreateResult", mock.Anything, dns.GetBulkZoneCreateResultRequest{RequestID: "req-2"}).
+			Return(&dns.GetBulkZoneCreateResultResponse{RequestID: "req-2", SuccessfullyCreatedZones: []string{"c.example.com"}}, nil).Once()
+
+		result, err := createBulkZones(context.Background(), client, query, zones, 2, logger)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.example.com", "c.example.com"}, result.Created)
+		assert.Equal(t, []dns.BulkFailedZone{{Zone: "b.example.com", FailureReason: "ZONE_ALREADY_EXISTS"}}, result.Failed)
+		require.Len(t, result.Requests, 2)
+		assert.Equal(t, "req-1", result.Requests[0].RequestID)
+		assert.Equal(t, "req-2", result.Requests[1].RequestID)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("CreateBulkZones", mock.Anything, mock.Anything).Return(&dns.CreateBulkZonesResponse{RequestID: "req-1"}, nil).Once()
+		client.On("GetBulkZoneCreateStatus", mock.Anything, dns.GetBulkZoneCreateStatusRequest{RequestID: "req-1"}).
+			Return(&dns.GetBulkZoneCreateStatusResponse{RequestID: "req-1", ZonesSubmitted: 3}, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		result, err := createBulkZones(ctx, client, query, zones, 10, logger)
+		assert.True(t, errors.Is(err, ErrBulkZonesTimeout), err)
+		assert.Empty(t, result.Created)
+	})
+}
+
+func TestListZones(t *testing.T) {
+	client := &dns.Mock{}
+	firstPage := make([]dns.ZoneResponse, 0, zoneListPageSize)
+	for i := 0; i < zoneListPageSize; i++ {
+		firstPage = append(firstPage, dns.ZoneResponse{Zone: fmt.Sprintf("zone%d.example.com", i), Type: "ALIAS"})
+	}
+	firstPage[0].Zone = "Brand.Example.net"
+	client.On("ListZones", mock.Anything, dns.ListZonesRequest{ContractIDs: "1-3CV382", Types: "ALIAS", Page: 1, PageSize: zoneListPageSize}).
+		Return(&dns.ZoneListResponse{Metadata: &dns.ListMetadata{TotalElements: zoneListPageSize + 1}, Zones: firstPage}, nil).Once()
+	client.On("ListZones", mock.Anything, dns.ListZonesRequest{ContractIDs: "1-3CV382", Types: "ALIAS", Page: 2, PageSize: zoneListPageSize}).
+		Return(&dns.ZoneListResponse{
+			Metadata: &dns.ListMetadata{TotalElements: zoneListPageSize + 1},
+			Zones:    []dns.ZoneResponse{{Zone: "brand.example.org", Type: "ALIAS", Target: "brand.example.com"}},
+		}, nil).Once()
+
+	zones, err := listZones(context.Background(), client, "1-3CV382", "alias")
+	require.NoError(t, err)
+	assert.Len(t, zones, zoneListPageSize+1)
+	assert.Contains(t, zones, "brand.example.net")
+	assert.Equal(t, "brand.example.com", zones["brand.example.org"].Target)
+	client.AssertExpectations(t)
+}
+
+func TestResDNSBulkZones(t *testing.T) {
+	bulkZonesPollMinimum, bulkZonesPollInterval = time.Millisecond, time.Millisecond
+	resourceName := "akamai_dns_bulk_zones.parked"
+	query := dns.ZoneQueryString{Contract: "1-3CV382", Group: "18432"}
+	aliases := func(target string) []dns.ZoneResponse {
+		return []dns.ZoneResponse{
+			{Zone: "brand-example.com", Type: "ALIAS", Target: target, Comment: "Managed by Terraform"},
+			{Zone: "brand.example.net", Type: "ALIAS", Target: target, Comment: "Managed by Terraform"},
+			{Zone: "brand.example.org", Type: "ALIAS", Target: target, Comment: "Managed by Terraform"},
+		}
+	}
+	listRequest := dns.ListZonesRequest{ContractIDs: "1-3CV382", Types: "ALIAS", Page: 1, PageSize: zoneListPageSize}
+
+	t.Run("create and update alias zones", func(t *testing.T) {
+		client := &dns.Mock{}
+		for i, batch := range [][]string{{"brand-example.com", "brand.example.net"}, {"brand.example.org"}} {
+			requestID := []string{"req-1", "req-2"}[i]
+			zoneCreates := make([]dns.ZoneCreate, 0, len(batch))
+			for _, zone := range batch {
+				zoneCreates = append(zoneCreates, dns.ZoneCreate{
+					Zone: zone, Type: "ALIAS", Target: "brand.example.com", Comment: "Managed by Terraform", Masters: []string{},
+				})
+			}
+			client.On("CreateBulkZones", mock.Anything, dns.CreateBulkZonesRequest{
+				BulkZones: &dns.BulkZonesCreate{Zones: zoneCreates}, ZoneQueryString: query,
+			}).Return(&dns.CreateBulkZonesResponse{RequestID: requestID}, nil).Once()
+			client.On("GetBulkZoneCreateStatus", mock.Anything, dns.GetBulkZoneCreateStatusRequest{RequestID: requestID}).
+				Return(&dns.GetBulkZoneCreateStatusResponse{RequestID: requestID, ZonesSubmitted: len(batch), SuccessCount: len(batch), IsComplete: true}, nil).Once()
+			client.On("GetBulkZoneCreateResult", mock.Anything, dns.GetBulkZoneCreateResultRequest{RequestID: requestID}).
+				Return(&dns.GetBulkZoneCreateResultResponse{RequestID: requestID, SuccessfullyCreatedZones: batch}, nil).Once()
+		}
+		client.On("ListZones", mock.Anything, listRequest).Return(&dns.ZoneListResponse{
+			Metadata: &dns.ListMetadata{TotalElements: 3}, Zones: aliases("brand.example.com"),
+		}, nil).Times(3)
+
+		// the target is updated zone by zone
+		for _, zone := range aliases("brand.example.com") {
+			getZoneResponse := dns.GetZoneResponse(zone)
+			client.On("GetZone", mock.Anything, dns.GetZoneRequest{Zone: zone.Zone}).Return(&getZoneResponse, nil).Once()
+			client.On("UpdateZone", mock.Anything, dns.UpdateZoneRequest{CreateZone: &dns.ZoneCreate{
+				Zone: zone.Zone, Type: "ALIAS", Target: "brand.example.io", Comment: "Managed by Terraform", Masters: []string{},
+			}}).Return(nil).Once()
+		}
+		client.On("ListZones", mock.Anything, listRequest).Return(&dns.ZoneListResponse{
+			Metadata: &dns.ListMetadata{TotalElements: 3}, Zones: aliases("brand.example.io"),
+		}, nil)
+
+		// work around to skip Delete which fails intentionally
+		err := os.Setenv("DNS_ZONE_SKIP_DELETE", "")
+		require.NoError(t, err)
+		defer func() {
+			err = os.Unsetenv("DNS_ZONE_SKIP_DELETE")
+			require.NoError(t, err)
+		}()
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsBulkZones/create_alias.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "id", "req-1"),
+							resource.TestCheckResourceAttr(resourceName, "zones.#", "3"),
+							resource.TestCheckResourceAttr(resourceName, "requests.#", "2"),
+							resource.TestCheckResourceAttr(resourceName, "requests.1.request_id", "req-2"),
+							resource.TestCheckResourceAttr(resourceName, "requests.1.success_count", "1"),
+						),
+					},
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsBulkZones/update_target.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "target", "brand.example.io"),
+							resource.TestCheckResourceAttr(resourceName, "zones.#", "3"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("alias zones require a target", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResDnsBulkZones/alias_without_target.tf"),
+						ExpectError: regexp.MustCompile("target must be populated for ALIAS zones"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
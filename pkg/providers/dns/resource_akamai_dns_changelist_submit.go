@@ -0,0 +1,174 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSChangeListSubmit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSChangeListSubmitCreate,
+		ReadContext:   schema.NoopContext,
+		UpdateContext: schema.NoopContext,
+		DeleteContext: resourceDNSChangeListSubmitDelete,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone whose changelist is submitted",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which submit the changelist again when changed, e.g. the record_sha of the staged records",
+			},
+			"discard_on_conflict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Discard the changelist when it conflicts with changes made to the zone after it was created, so that the next apply stages the records again",
+			},
+			"change_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tag of the submitted changelist",
+			},
+			"adds": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Recordsets added by the submitted changelist, as 'name TYPE'",
+			},
+			"updates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Recordsets changed by the submitted changelist, as 'name TYPE'",
+			},
+			"deletes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Recordsets removed by the submitted changelist, as 'name TYPE'",
+			},
+		},
+	}
+}
+
+func resourceDNSChangeListSubmitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSChangeListSubmitCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithField("zone", zone).Info("Changelist Submit")
+
+	lock := zoneLock(zone)
+	lock.Lock()
+	defer lock.Unlock()
+
+	client := inst.Client(meta)
+	changeList, err := client.GetChangeList(ctx, dns.GetChangeListRequest{Zone: zone})
+	if isNotFound(err) {
+		logger.WithField("zone", zone).Info("Zone has no changelist, nothing to submit")
+		if err := setChangeListDiff(d, &changeListDiff{}); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(zone)
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to read changelist for zone %s: %s", zone, err)
+	}
+	if changeList.Stale {
+		return changeListConflict(ctx, d, meta, zone)
+	}
+
+	diff, err := changeListsClientFor(meta).GetDiff(ctx, zone)
+	if err != nil {
+		return diag.Errorf("failed to read changelist diff for zone %s: %s", zone, err)
+	}
+
+	if err := client.SubmitChangeList(ctx, dns.SubmitChangeListRequest{Zone: zone}); err != nil {
+		var apiError *dns.Error
+		if errors.As(err, &apiError) && apiError.StatusCode == http.StatusConflict {
+			return changeListConflict(ctx, d, meta, zone)
+		}
+		return diag.Errorf("failed to submit changelist for zone %s: %s", zone, err)
+	}
+
+	if err := setChangeListDiff(d, diff); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(zone)
+
+	return nil
+}
+
+func resourceDNSChangeListSubmitDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSChangeListSubmitDelete")
+
+	// submitted changes cannot be reverted, so the resource is only removed from state
+	logger.WithField("zone", d.Id()).Info("Changelist Submit Delete: submitted changes are left unchanged")
+
+	return nil
+}
+
+// changeListConflict reports a changelist which cannot be submitted because the zone was modified after its creation
+func changeListConflict(ctx context.Context, d *schema.ResourceData, meta meta.Meta, zone string) diag.Diagnostics {
+	err := fmt.Errorf("%w: changelist for zone %s cannot be submitted", ErrStaleChangeList, zone)
+	if !d.Get("discard_on_conflict").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   "Discard the changelist, or set discard_on_conflict, and apply again to stage the records on top of the current zone",
+		}}
+	}
+
+	if delErr := changeListsClientFor(meta).DeleteChangeList(ctx, zone); delErr != nil {
+		return diag.FromErr(errors.Join(err, delErr))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   "The changelist was discarded. Apply again to stage the records on top of the current zone",
+	}}
+}
+
+func setChangeListDiff(d *schema.ResourceData, diff *changeListDiff) error {
+	return tf.SetAttrs(d, map[string]interface{}{
+		"change_tag": diff.ChangeTag,
+		"adds":       recordSetNames(diff.Adds),
+		"updates":    recordSetNames(diff.Updates),
+		"deletes":    recordSetNames(diff.Deletes),
+	})
+}
+
+func recordSetNames(recordSets []dns.RecordSet) []string {
+	names := make([]string, 0, len(recordSets))
+	for _, rs := range recordSets {
+		names = append(names, fmt.Sprintf("%s %s", rs.Name, rs.Type))
+	}
+	sort.Strings(names)
+	return names
+}
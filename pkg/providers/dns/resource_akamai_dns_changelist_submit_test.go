@@ -0,0 +1,102 @@
+package dns
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResDNSChangeListSubmit(t *testing.T) {
+	zoneRequest := dns.GetChangeListRequest{Zone: "exampleterraform.io"}
+
+	t.Run("submit changelist", func(t *testing.T) {
+		client := &dns.Mock{}
+		changeLists := &mockChangeLists{}
+		client.On("GetChangeList", mock.Anything, zoneRequest).Return(&dns.GetChangeListResponse{Zone: "exampleterraform.io"}, nil).Once()
+		changeLists.On("GetDiff", mock.Anything, "exampleterraform.io").Return(&changeListDiff{
+			Zone:      "exampleterraform.io",
+			ChangeTag: "tag-1",
+			Adds:      []dns.RecordSet{{Name: "_verify.exampleterraform.io", Type: "TXT"}},
+			Updates:   []dns.RecordSet{{Name: "www.exampleterraform.io", Type: "CNAME"}},
+		}, nil).Once()
+		client.On("SubmitChangeList", mock.Anything, dns.SubmitChangeListRequest{Zone: "exampleterraform.io"}).Return(nil).Once()
+
+		useClient(client, func() {
+			useChangeLists(changeLists, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{
+						{
+							Config: testutils.LoadFixtureString(t, "testdata/TestResDnsChangeListSubmit/submit.tf"),
+							Check: resource.ComposeTestCheckFunc(
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "id", "exampleterraform.io"),
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "change_tag", "tag-1"),
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "adds.#", "1"),
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "adds.0", "_verify.exampleterraform.io TXT"),
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "updates.0", "www.exampleterraform.io CNAME"),
+								resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "deletes.#", "0"),
+							),
+						},
+					},
+				})
+			})
+		})
+
+		client.AssertExpectations(t)
+		changeLists.AssertExpectations(t)
+	})
+
+	t.Run("nothing to submit", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("GetChangeList", mock.Anything, zoneRequest).Return(nil, &dns.Error{StatusCode: http.StatusNotFound}).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsChangeListSubmit/submit.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "id", "exampleterraform.io"),
+							resource.TestCheckResourceAttr("akamai_dns_changelist_submit.test", "adds.#", "0"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("conflict discards changelist", func(t *testing.T) {
+		client := &dns.Mock{}
+		changeLists := &mockChangeLists{}
+		client.On("GetChangeList", mock.Anything, zoneRequest).Return(&dns.GetChangeListResponse{Zone: "exampleterraform.io"}, nil).Once()
+		changeLists.On("GetDiff", mock.Anything, "exampleterraform.io").Return(&changeListDiff{Zone: "exampleterraform.io"}, nil).Once()
+		client.On("SubmitChangeList", mock.Anything, dns.SubmitChangeListRequest{Zone: "exampleterraform.io"}).
+			Return(&dns.Error{StatusCode: http.StatusConflict}).Once()
+		changeLists.On("DeleteChangeList", mock.Anything, "exampleterraform.io").Return(nil).Once()
+
+		useClient(client, func() {
+			useChangeLists(changeLists, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{
+						{
+							Config:      testutils.LoadFixtureString(t, "testdata/TestResDnsChangeListSubmit/discard.tf"),
+							ExpectError: regexp.MustCompile("the zone was modified after the changelist was created"),
+						},
+					},
+				})
+			})
+		})
+
+		client.AssertExpectations(t)
+		changeLists.AssertExpectations(t)
+	})
+}
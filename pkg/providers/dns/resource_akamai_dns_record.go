@@ -14,7 +14,6 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
@@ -22,6 +21,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/logger"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/svcparams"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/txtrecord"
 	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,9 +29,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// Retry count for save, update and delete
-const opRetryCount = 5
-
 func resourceDNSv2Record() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDNSRecordCreate,
@@ -41,7 +38,12 @@ func resourceDNSv2Record() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDNSRecordImport,
 		},
-		Schema: getResourceDNSRecordSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: &propagationTimeout,
+			Update: &propagationTimeout,
+		},
+		CustomizeDiff: validateServiceRecordDiff,
+		Schema:        getResourceDNSRecordSchema(),
 	}
 }
 
@@ -321,17 +323,103 @@ func getResourceDNSRecordSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"use_changelist": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Stage changes of the record in the changelist of the zone instead of applying them immediately. The changes go live when the changelist is submitted with akamai_dns_changelist_submit",
+		},
+		"wait_for_propagation": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			ConflictsWith: []string{"use_changelist"},
+			Description:   "Wait until the authoritative nameservers of the zone serve the record after it is created or updated",
+		},
+		"propagation_resolvers": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			RequiredWith: []string{"wait_for_propagation"},
+			Description:  "Nameservers, as 'host' or 'host:port', queried instead of the authorities of the zone when waiting for propagation",
+		},
 		"svc_priority": {
-			Type:     schema.TypeInt,
-			Optional: true,
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 65535)),
 		},
 		"svc_params": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    svcParamAttributes,
+			DiffSuppressFunc: dnsRecordSvcParamsSuppress,
 		},
 		"target_name": {
-			Type:     schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: dnsRecordFieldDotSuffixSuppress,
+		},
+		"svc_mandatory": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					svcparams.KeyALPN, svcparams.KeyNoDefaultALPN, svcparams.KeyPort,
+					svcparams.KeyIPv4Hint, svcparams.KeyECH, svcparams.KeyIPv6Hint,
+				}, false)),
+			},
+			ConflictsWith: []string{"svc_params"},
+			Description:   "Service parameter keys which clients must support to use the SVCB or HTTPS record",
+		},
+		"svc_alpn": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 255)),
+			},
+			ConflictsWith: []string{"svc_params"},
+			Description:   "Protocol identifiers supported by the service of the SVCB or HTTPS record, in the order of preference",
+		},
+		"svc_no_default_alpn": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			ConflictsWith: []string{"svc_params"},
+			Description:   "Whether the service of the SVCB or HTTPS record does not support the default protocol. Requires svc_alpn",
+		},
+		"svc_port": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+			ConflictsWith:    []string{"svc_params"},
+			Description:      "Alternative port of the service of the SVCB or HTTPS record",
+		},
+		"svc_ipv4hint": {
+			Type:     schema.TypeList,
 			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+			ConflictsWith: []string{"svc_params"},
+			Description:   "IPv4 addresses of the service of the SVCB or HTTPS record",
+		},
+		"svc_ech": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
+			ConflictsWith:    []string{"svc_params"},
+			Description:      "Encrypted ClientHello configuration list of the service of the SVCB or HTTPS record, in base64",
+		},
+		"svc_ipv6hint": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+			},
+			ConflictsWith:    []string{"svc_params"},
+			DiffSuppressFunc: dnsRecordAddressSuppress,
+			Description:      "IPv6 addresses of the service of the SVCB or HTTPS record",
 		},
 	}
 }
@@ -559,43 +647,6 @@ func diffQuotedDNSRecord(oldTargetList []string, newTargetList []string, old str
 	return false
 }
 
-// Lock per record type
-var recordCreateLock = map[string]*sync.Mutex{
-	"A":          {},
-	"AAAA":       {},
-	"AFSDB":      {},
-	"AKAMAICDN":  {},
-	"AKAMAITLC":  {},
-	"CAA":        {},
-	"CERT":       {},
-	"CNAME":      {},
-	"HINFO":      {},
-	"LOC":        {},
-	"MX":         {},
-	"NAPTR":      {},
-	"NS":         {},
-	"PTR":        {},
-	"RP":         {},
-	"SOA":        {},
-	"SRV":        {},
-	"SPF":        {},
-	"SSHFP":      {},
-	"TLSA":       {},
-	"TXT":        {},
-	"DNSKEY":     {},
-	"DS":         {},
-	"NSEC3":      {},
-	"NSEC3PARAM": {},
-	"RRSIG":      {},
-	"SVCB":       {},
-	"HTTPS":      {},
-}
-
-// Retrieves record lock per record type
-func getRecordLock(recordType string) *sync.Mutex {
-	return recordCreateLock[recordType]
-}
-
 func bumpSoaSerial(ctx context.Context, d *schema.ResourceData, meta meta.Meta, zone, host string, logger log.Interface) (*dns.RecordBody, error) {
 	// Get SOA Record
 	recordset, err := inst.Client(meta).GetRecord(ctx, dns.GetRecordRequest{
@@ -660,49 +711,43 @@ func executeRecordFunction(ctx context.Context, meta meta.Meta, name string, d *
 
 	logger.Debugf("executeRecordFunction - zone: %s, host: %s, recordtype: %s", zone, host, recordType)
 	// DNS API can have Concurrency issues
-	opRetry := opRetryCount
-	e := execFunc(ctx, meta, fn, rec, zone, rlock)
-	for e != nil && opRetry > 0 {
-		apiError, ok := e.(*dns.Error)
-		// prep failure or network failure?
-		if !ok || apiError.StatusCode < http.StatusBadRequest {
-			logger.Errorf("executeRecordFunction - %s Record failed for record [%s] [%s] [%s] ", name, zone, host, recordType)
+	retry := recordRetryFor(meta)
+	e := recordError(execFunc(ctx, meta, fn, rec, zone, rlock))
+	for attempt := 1; e != nil; attempt++ {
+		if name == "DELETE" && isNotFound(e) {
+			// record doesn't exist
+			d.SetId("")
+			logger.Debugf("executeRecordFunction - %s [WARNING] %s", name, "Record not found")
+			return nil
+		}
+		soaSerial := (name == "CREATE" || name == "UPDATE") && errors.Is(e, ErrSOASerialNotIncremented)
+		if !soaSerial && !errors.Is(e, ErrRecordConflict) {
+			logger.Errorf("executeRecordFunction - %s Record failed for record [%s] [%s] [%s]: %s", name, zone, host, recordType, e.Error())
 			return e
 		}
-		if apiError.StatusCode == http.StatusConflict {
-			logger.Debug("executeRecordFunction - Concurrency Conflict")
-			opRetry--
-			time.Sleep(100 * time.Millisecond)
-			e = execFunc(ctx, meta, fn, rec, zone, rlock)
-			continue
+		if attempt > retry.max {
+			logger.Errorf("executeRecordFunction - %s Record failed for record [%s] [%s] [%s] after %d retries", name, zone, host, recordType, retry.max)
+			return e
 		}
-		// relying on error string is not a good idea, better to introduce separate error variables for each cause or error codes
-		if (name == "CREATE" || name == "UPDATE") && strings.Contains(e.Error(), "SOA serial number must be incremented") {
-			logger.Debug("executeRecordFunction - SOA Serial Number needs incrementing")
-			opRetry--
-			time.Sleep(5 * time.Second) // let things quiesce
-			rec, err := bumpSoaSerial(ctx, d, meta, zone, host, logger)
-			if err != nil {
+
+		logger.Debugf("executeRecordFunction - retrying %s after %s: %s", name, retry.backoff(attempt), e.Error())
+		if err := retry.wait(ctx, attempt); err != nil {
+			return err
+		}
+		if soaSerial {
+			var err error
+			if rec, err = bumpSoaSerial(ctx, d, meta, zone, host, logger); err != nil {
 				return err
 			}
-			e = execFunc(ctx, meta, fn, rec, zone, rlock)
-			continue
-		}
-		if name == "DELETE" && apiError.StatusCode == http.StatusNotFound {
-			// record doesn't exist
-			d.SetId("")
-			logger.Debugf("executeRecordFunction - %s [WARNING] %s", name, "Record not found")
-			break
 		}
-		logger.Debugf("executeRecordFunction - %s [ERROR] %s", name, e.Error())
-		return e
+		e = recordError(execFunc(ctx, meta, fn, rec, zone, rlock))
 	}
 	return nil
 }
 
 // Create a new DNS Record
 func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// only allow one record per record type to be created at a time
+	// only allow one write per zone at a time
 	// this prevents lost data if you are using a counter/dynamic variables
 	// in your config.tf which might overwrite each other
 
@@ -742,9 +787,14 @@ func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m inte
 		})
 	}
 
-	// serialize record creates of same type
-	getRecordLock(recordType).Lock()
-	defer getRecordLock(recordType).Unlock()
+	if d.Get("use_changelist").(bool) {
+		return resourceDNSRecordStage(ctx, d, meta, logger)
+	}
+
+	// serialize writes to the same zone
+	zoneLock(zone).Lock()
+	unlock := sync.OnceFunc(zoneLock(zone).Unlock)
+	defer unlock()
 
 	if recordType == RRTypeSoa {
 		logger.Debug("Attempting to create a SOA record")
@@ -856,15 +906,16 @@ func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m inte
 		// Backwards compatibility
 		d.SetId(fmt.Sprintf("%s-%s-%s-%s", zone, host, recordType, sha1hash))
 	}
-	// Lock won't be release til after Read ...
-	return resourceDNSRecordRead(ctx, d, meta)
+	// the zone is not locked while waiting, so that other writes to it are not held back
+	unlock()
+	return readAfterRecordPropagation(ctx, meta, d, zone, recordCreate, logger)
 
 }
 
 // nolint:gocyclo
 // Update DNS Record
 func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// only allow one record per record type to be updated at a time
+	// only allow one write per zone at a time
 	// this prevents lost data if you are using a counter/dynamic variables
 	// in your config.tf which might overwrite each other
 
@@ -921,9 +972,14 @@ func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		})
 	}
 
-	// serialize record updates of same type
-	getRecordLock(recordType).Lock()
-	defer getRecordLock(recordType).Unlock()
+	if d.Get("use_changelist").(bool) {
+		return resourceDNSRecordStage(ctx, d, meta, logger)
+	}
+
+	// serialize writes to the same zone
+	zoneLock(zone).Lock()
+	unlock := sync.OnceFunc(zoneLock(zone).Unlock)
+	defer unlock()
 
 	if recordType == RRTypeSoa {
 		// need to get current serial and increment as part of update
@@ -1024,8 +1080,9 @@ func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	} else {
 		d.SetId(fmt.Sprintf("%s-%s-%s-%s", zone, host, recordType, sha1hash))
 	}
-	// Lock not released until after Read ...
-	return resourceDNSRecordRead(ctx, d, meta)
+	// the zone is not locked while waiting, so that other writes to it are not held back
+	unlock()
+	return readAfterRecordPropagation(ctx, meta, d, zone, recordCreate, logger)
 }
 
 //nolint:gocyclo
@@ -1060,6 +1117,15 @@ func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interf
 		"recordtype": recordType,
 	}).Info("Record Read")
 
+	pending, err := recordChangePending(ctx, meta, d, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pending {
+		logger.Debug("READ Record change is pending in the zone changelist. Keeping state")
+		return nil
+	}
+
 	recordCreate, err := bindRecord(ctx, meta, d, logger)
 	if err != nil {
 		return append(diags, diag.Diagnostic{
@@ -1109,6 +1175,10 @@ func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interf
 		}
 		logger.Errorf("READ Record Not Found: %s", e.Error())
 		d.SetId("")
+		if d.Get("use_changelist").(bool) {
+			// the change was discarded with its changelist, the record is staged again by the next apply
+			return nil
+		}
 		return diag.Errorf("Record not found")
 	}
 
@@ -1193,6 +1263,19 @@ func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interf
 		if err := d.Set("target", resolvedTargets); err != nil {
 			return diag.Errorf("%v: %s", tf.ErrValueSet, err.Error())
 		}
+	case RRTypeSvcb, RRTypeHTTPS:
+		for fname, fvalue := range rdataFieldMap {
+			if fname == "svc_params" {
+				continue
+			}
+			if err := d.Set(fname, fvalue); err != nil {
+				return diag.Errorf("%v: %s", tf.ErrValueSet, err.Error())
+			}
+		}
+		rawParams, _ := rdataFieldMap["svc_params"].(string)
+		if err := setServiceParams(d, rawParams); err != nil {
+			return diag.FromErr(err)
+		}
 	default:
 		// Parse Rdata. MX special
 		for fname, fvalue := range rdataFieldMap {
@@ -1382,9 +1465,18 @@ func resourceDNSRecordDelete(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	logger.Infof("Record Delete. zone: %s, host: %s, recordtype: %s", zone, host, recordType)
 	logger.Info("Record Delete.")
-	// serialize record updates of same type
-	getRecordLock(recordType).Lock()
-	defer getRecordLock(recordType).Unlock()
+
+	if d.Get("use_changelist").(bool) {
+		if err := stageRecordChange(ctx, meta, d, true); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("")
+		return nil
+	}
+
+	// serialize writes to the same zone
+	zoneLock(zone).Lock()
+	defer zoneLock(zone).Unlock()
 
 	target, err := tf.GetListValue("target", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
@@ -2093,8 +2185,8 @@ func newRecordCreate(ctx context.Context, meta meta.Meta, d *schema.ResourceData
 		if err != nil && !errors.Is(err, tf.ErrNotFound) {
 			return dns.RecordBody{}, err
 		}
-		params, err := tf.GetStringValue("svc_params", d)
-		if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		params, err := svcParamsString(d)
+		if err != nil {
 			return dns.RecordBody{}, err
 		}
 		records := []string{strconv.Itoa(pri) + " " + tname + " " + params}
@@ -2169,6 +2261,9 @@ func validateRecord(d *schema.ResourceData) error {
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
 		return err
 	}
+	if err := validatePropagation(d); err != nil {
+		return err
+	}
 
 	switch recordType {
 	case RRTypeA, RRTypeAkamaiCdn, RRTypeCname, RRTypeLoc, RRTypeNs, RRTypePtr, RRTypeSpf, RRTypeTxt:
@@ -2955,40 +3050,11 @@ func checkHTTPSRecord(d *schema.ResourceData) error {
 }
 
 func checkServiceRecord(d *schema.ResourceData, rtype string) error {
-	pri, err := tf.GetIntValue("svc_priority", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return err
-	}
-	tname, err := tf.GetStringValue("target_name", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return err
-	}
-	params, err := tf.GetStringValue("svc_params", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return err
-	}
-
 	if err := checkBasicRecordTypes(d); err != nil {
 		return err
 	}
 
-	if pri < 0 || pri > 65535 {
-		return fmt.Errorf("configuration argument svc_priority must be positive int for %s", rtype)
-	}
-
-	if tname == "" {
-		return fmt.Errorf("configuration argument target_name must be set for %s", rtype)
-	}
-
-	if params == "" && pri > 0 {
-		return fmt.Errorf("configuration argument svc_params must be set for %s", rtype)
-	}
-
-	if pri == 0 && params != "" {
-		return fmt.Errorf("configuration argument svc_params cannot be set for %s if svc_priority is zero", rtype)
-	}
-
-	return nil
+	return checkServiceParams(d, rtype)
 }
 
 func resolveTxtRecordTargets(denormalized, normalized []string) ([]string, error) {
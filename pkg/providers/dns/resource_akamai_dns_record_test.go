@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
@@ -16,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
 )
 
 func TestResDnsRecord(t *testing.T) {
@@ -640,6 +643,147 @@ func TestResDnsRecord(t *testing.T) {
 
 		client.AssertExpectations(t)
 	})
+	t.Run("HTTPS record with svc attributes", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		getRequest := dns.GetRecordRequest{Zone: "exampleterraform.io", Name: "exampleterraform.io", RecordType: "HTTPS"}
+		target := []string{`1 . mandatory=port alpn="h2,h3" port=8443 ipv6hint=2001:db8::1`}
+		client.On("GetRecord", mock.Anything, getRequest).Return(nil, notFound).Once()
+
+		client.On("CreateRecord",
+			mock.Anything,
+			dns.CreateRecordRequest{
+				Record: &dns.RecordBody{
+					Name:       "exampleterraform.io",
+					RecordType: "HTTPS",
+					TTL:        300,
+					Target:     target,
+				},
+				Zone:    "exampleterraform.io",
+				RecLock: []bool{false},
+			},
+		).Return(nil)
+
+		client.On("GetRecord", mock.Anything, getRequest).Return(&dns.GetRecordResponse{
+			Name:       "exampleterraform.io",
+			RecordType: "HTTPS",
+			TTL:        300,
+			Target:     target,
+		}, nil)
+
+		client.On("ParseRData", mock.Anything, "HTTPS", mock.AnythingOfType("[]string")).Return(map[string]interface{}{
+			"svc_priority": 1,
+			"target_name":  ".",
+			"svc_params":   `mandatory=port alpn="h2,h3" port=8443 ipv6hint=2001:db8::1`,
+		})
+
+		client.On("ProcessRdata", mock.Anything, target, "HTTPS").Return(target)
+
+		client.On("DeleteRecord",
+			mock.Anything,
+			dns.DeleteRecordRequest{Zone: "exampleterraform.io", Name: "exampleterraform.io", RecordType: "HTTPS", RecLock: []bool{false}},
+		).Return(nil)
+
+		resourceName := "akamai_dns_record.https_record"
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsRecord/https/create_svc_attributes.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "svc_priority", "1"),
+							resource.TestCheckResourceAttr(resourceName, "svc_params", ""),
+							resource.TestCheckResourceAttr(resourceName, "svc_alpn.#", "2"),
+							resource.TestCheckResourceAttr(resourceName, "svc_port", "8443"),
+							resource.TestCheckResourceAttr(resourceName, "svc_ipv6hint.0", "2001:db8::1"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+	t.Run("HTTPS record with mandatory key which is not set - invalid", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResDnsRecord/https/create_invalid_mandatory.tf"),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile("mandatory key ech is not set"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+	t.Run("wait for propagation times out", func(t *testing.T) {
+		origMinimum, origInterval := propagationPollMinimum, propagationPollInterval
+		propagationPollMinimum, propagationPollInterval = 10*time.Millisecond, 10*time.Millisecond
+		defer func() { propagationPollMinimum, propagationPollInterval = origMinimum, origInterval }()
+
+		// the nameserver keeps serving the old address, so the record never propagates
+		nameserver := newTestNameserver(t)
+		nameserver.serve("exampleterraform.io.", dnsmessage.TypeA, &dnsmessage.AResource{A: netip.MustParseAddr("10.0.0.1").As4()})
+
+		client := &dns.Mock{}
+		getRequest := dns.GetRecordRequest{Zone: "exampleterraform.io", Name: "exampleterraform.io", RecordType: "A"}
+		target := []string{"10.0.0.2"}
+		client.On("GetRecord", mock.Anything, getRequest).Return(nil, notFound).Once()
+		client.On("CreateRecord", mock.Anything, mock.AnythingOfType("dns.CreateRecordRequest")).Return(nil).Once()
+		client.On("GetRecord", mock.Anything, getRequest).Return(&dns.GetRecordResponse{
+			Name:       "exampleterraform.io",
+			RecordType: "A",
+			TTL:        300,
+			Active:     true,
+			Target:     target,
+		}, nil)
+		client.On("ParseRData", mock.Anything, "A", mock.AnythingOfType("[]string")).Return(map[string]interface{}{})
+		client.On("ProcessRdata", mock.Anything, mock.AnythingOfType("[]string"), "A").Return(target)
+		client.On("DeleteRecord",
+			mock.Anything,
+			dns.DeleteRecordRequest{Zone: "exampleterraform.io", Name: "exampleterraform.io", RecordType: "A", RecLock: []bool{false}},
+		).Return(nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						// the saved record is kept in state, so it is deleted on destroy
+						Config:      fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestResDnsRecord/propagation/create_timeout.tf"), nameserver.addr),
+						ExpectError: regexp.MustCompile("timed out waiting for the records to be served"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+	t.Run("wait for propagation of unsupported record type - invalid", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResDnsRecord/propagation/create_unsupported_type.tf"),
+						ExpectError: regexp.MustCompile("propagation cannot be verified for record type SPF"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
 
 }
 
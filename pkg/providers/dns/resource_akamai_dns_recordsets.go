@@ -0,0 +1,505 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSRecordSets() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSRecordSetsCreate,
+		ReadContext:   resourceDNSRecordSetsRead,
+		UpdateContext: resourceDNSRecordSetsUpdate,
+		DeleteContext: resourceDNSRecordSetsDelete,
+		CustomizeDiff: recordSetsCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSRecordSetsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone of the recordsets",
+			},
+			"recordset": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Recordsets owned by the resource. Each name and type pair can be given only once",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: tf.IsNotBlank,
+							Description:      "Fully qualified name of the recordset, without the trailing dot",
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(bulkRecordTypes(), false)),
+							Description:      "Type of the recordset. SOA and AKAMAITLC records cannot be managed in bulk",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "TTL of the recordset in seconds",
+						},
+						"rdata": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Records of the recordset in the master file format",
+						},
+					},
+				},
+			},
+			"changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Recordsets added, changed or removed by the last apply. The plan lists them before they are applied",
+			},
+		},
+	}
+}
+
+// bulkRecordTypes returns the record types which can be managed by akamai_dns_recordsets
+func bulkRecordTypes() []string {
+	var types []string
+	for rtype := range zoneFileRecordTypes {
+		if rtype != RRTypeSoa && rtype != RRTypeAkamaiTlc {
+			types = append(types, rtype)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// recordSetKey identifies a recordset in a zone
+type recordSetKey struct {
+	name  string
+	rtype string
+}
+
+func (k recordSetKey) String() string {
+	return k.name + " " + k.rtype
+}
+
+func keyOf(recordSet dns.RecordSet) recordSetKey {
+	return recordSetKey{name: strings.ToLower(strings.TrimSuffix(recordSet.Name, ".")), rtype: strings.ToUpper(recordSet.Type)}
+}
+
+// recordSetsFromSet reads recordset blocks of the resource
+func recordSetsFromSet(set *schema.Set) []dns.RecordSet {
+	recordSets := make([]dns.RecordSet, 0, set.Len())
+	for _, item := range set.List() {
+		m := item.(map[string]interface{})
+		var rdata []string
+		for _, r := range m["rdata"].([]interface{}) {
+			rdata = append(rdata, r.(string))
+		}
+		recordSets = append(recordSets, dns.RecordSet{
+			Name:  m["name"].(string),
+			Type:  m["type"].(string),
+			TTL:   m["ttl"].(int),
+			Rdata: rdata,
+		})
+	}
+	sort.Slice(recordSets, func(i, j int) bool {
+		return keyOf(recordSets[i]).String() < keyOf(recordSets[j]).String()
+	})
+	return recordSets
+}
+
+func recordSetsToSet(recordSets []dns.RecordSet) []interface{} {
+	result := make([]interface{}, 0, len(recordSets))
+	for _, recordSet := range recordSets {
+		result = append(result, map[string]interface{}{
+			"name":  recordSet.Name,
+			"type":  recordSet.Type,
+			"ttl":   recordSet.TTL,
+			"rdata": recordSet.Rdata,
+		})
+	}
+	return result
+}
+
+// validateRecordSets checks that recordsets belong to the zone, are not duplicated and pass the akamai_dns_record validation
+func validateRecordSets(zone string, recordSets []dns.RecordSet) error {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	seen := make(map[recordSetKey]struct{}, len(recordSets))
+	var errs []error
+	for _, recordSet := range recordSets {
+		key := keyOf(recordSet)
+		if _, ok := seen[key]; ok {
+			errs = append(errs, fmt.Errorf("recordset %s is declared more than once", key))
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if key.name != zone && !strings.HasSuffix(key.name, "."+zone) {
+			errs = append(errs, fmt.Errorf("recordset %s does not belong to zone %s", key, zone))
+			continue
+		}
+		for _, rdata := range recordSet.Rdata {
+			record, err := recordSetRecord(zone, recordSet, rdata)
+			if err == nil {
+				err = record.validate()
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("recordset %s: %w", key, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// recordSetRecord converts a single rdata of the recordset to a zone file record
+func recordSetRecord(zone string, recordSet dns.RecordSet, rdata string) (zoneFileRecord, error) {
+	key := keyOf(recordSet)
+	if _, ok := zoneFileRecordTypes[key.rtype]; !ok {
+		return zoneFileRecord{}, fmt.Errorf("%w: record type %s is not supported", ErrZoneFile, key.rtype)
+	}
+	entries, err := splitZoneFile(rdata)
+	if err != nil {
+		return zoneFileRecord{}, err
+	}
+	if len(entries) != 1 {
+		return zoneFileRecord{}, fmt.Errorf("%w: rdata %q must be a single record", ErrZoneFile, rdata)
+	}
+	return zoneFileRecord{
+		line:   1,
+		name:   key.name,
+		ttl:    recordSet.TTL,
+		rtype:  key.rtype,
+		fields: normalizeZoneFileFields(key.rtype, entries[0].tokens, zone),
+	}, nil
+}
+
+// recordSetsEqual compares recordsets ignoring the order of rdata and the notation of names and addresses in rdata
+func recordSetsEqual(zone string, a, b dns.RecordSet) bool {
+	if keyOf(a) != keyOf(b) || a.TTL != b.TTL || len(a.Rdata) != len(b.Rdata) {
+		return false
+	}
+	normalize := func(recordSet dns.RecordSet) []string {
+		var keys []string
+		for _, rdata := range recordSet.Rdata {
+			record, err := recordSetRecord(zone, recordSet, rdata)
+			if err != nil {
+				keys = append(keys, rdata)
+				continue
+			}
+			keys = append(keys, record.key())
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	aKeys, bKeys := normalize(a), normalize(b)
+	for i := range aKeys {
+		if aKeys[i] != bKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// diffRecordSets returns human-readable changes between the old and new recordsets
+func diffRecordSets(zone string, old, new []dns.RecordSet) []string {
+	oldByKey := make(map[recordSetKey]dns.RecordSet, len(old))
+	for _, recordSet := range old {
+		oldByKey[keyOf(recordSet)] = recordSet
+	}
+	var changes []string
+	newKeys := make(map[recordSetKey]struct{}, len(new))
+	for _, recordSet := range new {
+		key := keyOf(recordSet)
+		newKeys[key] = struct{}{}
+		previous, ok := oldByKey[key]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("add %s", key))
+		case !recordSetsEqual(zone, previous, recordSet):
+			changes = append(changes, fmt.Sprintf("change %s", key))
+		}
+	}
+	for _, recordSet := range old {
+		if _, ok := newKeys[keyOf(recordSet)]; !ok {
+			changes = append(changes, fmt.Sprintf("remove %s", keyOf(recordSet)))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// recordSetsCustomDiff implements a schema.CustomizeDiffFunc for akamai_dns_recordsets resource.
+//
+// It validates the recordsets and lists the recordsets which are going to be added, changed or removed in 'changes'.
+func recordSetsCustomDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("recordset") || !d.NewValueKnown("zone") {
+		return nil
+	}
+	zone := d.Get("zone").(string)
+	o, n := d.GetChange("recordset")
+	newRecordSets := recordSetsFromSet(n.(*schema.Set))
+	if err := validateRecordSets(zone, newRecordSets); err != nil {
+		return err
+	}
+
+	changes := diffRecordSets(zone, recordSetsFromSet(o.(*schema.Set)), newRecordSets)
+	if len(changes) == 0 {
+		return nil
+	}
+	return d.SetNew("changes", changes)
+}
+
+func resourceDNSRecordSetsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSRecordSetsCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	recordSets := recordSetsFromSet(d.Get("recordset").(*schema.Set))
+	if err := validateRecordSets(zone, recordSets); err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithFields(log.Fields{"zone": zone, "recordsets": len(recordSets)}).Info("Record Sets Create")
+
+	err = retryRecordSetsConflict(ctx, meta, logger, zone, func() error {
+		return inst.Client(meta).CreateRecordSets(ctx, dns.CreateRecordSetsRequest{
+			Zone:       zone,
+			RecordSets: &dns.RecordSets{RecordSets: recordSets},
+		})
+	})
+	if err != nil {
+		return diag.Errorf("failed to create recordsets in zone %s: %s", zone, err)
+	}
+	d.SetId(zone)
+
+	return resourceDNSRecordSetsRead(ctx, d, m)
+}
+
+func resourceDNSRecordSetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSRecordSetsRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	current, err := getAllRecordSets(ctx, meta, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentByKey := make(map[recordSetKey]dns.RecordSet, len(current))
+	for _, recordSet := range current {
+		currentByKey[keyOf(recordSet)] = recordSet
+	}
+
+	// recordsets are kept in the configured notation unless they were changed outside of terraform
+	var recordSets []dns.RecordSet
+	for _, owned := range recordSetsFromSet(d.Get("recordset").(*schema.Set)) {
+		remote, ok := currentByKey[keyOf(owned)]
+		if !ok {
+			logger.Warnf("recordset %s not found in zone %s", keyOf(owned), zone)
+			continue
+		}
+		if recordSetsEqual(zone, owned, remote) {
+			recordSets = append(recordSets, owned)
+			continue
+		}
+		remote.Name = owned.Name
+		recordSets = append(recordSets, remote)
+	}
+
+	attrs := map[string]interface{}{
+		"zone":      zone,
+		"recordset": recordSetsToSet(recordSets),
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDNSRecordSetsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSRecordSetsUpdate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	o, n := d.GetChange("recordset")
+	oldRecordSets, newRecordSets := recordSetsFromSet(o.(*schema.Set)), recordSetsFromSet(n.(*schema.Set))
+	if err := validateRecordSets(zone, newRecordSets); err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithFields(log.Fields{
+		"zone":    zone,
+		"changes": diffRecordSets(zone, oldRecordSets, newRecordSets),
+	}).Info("Record Sets Update")
+
+	if err := replaceRecordSets(ctx, meta, logger, zone, oldRecordSets, newRecordSets); err != nil {
+		d.Partial(true)
+		return diag.Errorf("failed to update recordsets in zone %s: %s", zone, err)
+	}
+
+	return resourceDNSRecordSetsRead(ctx, d, m)
+}
+
+func resourceDNSRecordSetsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSRecordSetsDelete")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	owned := recordSetsFromSet(d.Get("recordset").(*schema.Set))
+	logger.WithFields(log.Fields{"zone": zone, "recordsets": len(owned)}).Info("Record Sets Delete")
+
+	if err := replaceRecordSets(ctx, meta, logger, zone, owned, nil); err != nil {
+		return diag.Errorf("failed to delete recordsets in zone %s: %s", zone, err)
+	}
+
+	return nil
+}
+
+// resourceDNSRecordSetsImport imports all recordsets of the zone except SOA and read-only AKAMAITLC records
+func resourceDNSRecordSetsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSRecordSetsImport")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	current, err := getAllRecordSets(ctx, meta, zone)
+	if err != nil {
+		return nil, err
+	}
+	var recordSets []dns.RecordSet
+	for _, recordSet := range current {
+		if rtype := keyOf(recordSet).rtype; rtype != RRTypeSoa && rtype != RRTypeAkamaiTlc {
+			recordSets = append(recordSets, recordSet)
+		}
+	}
+	if err := d.Set("recordset", recordSetsToSet(recordSets)); err != nil {
+		return nil, fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getAllRecordSets returns all recordsets of the zone
+func getAllRecordSets(ctx context.Context, meta meta.Meta, zone string) ([]dns.RecordSet, error) {
+	resp, err := inst.Client(meta).GetRecordSets(ctx, dns.GetRecordSetsRequest{
+		Zone:      zone,
+		QueryArgs: &dns.RecordSetQueryArgs{ShowAll: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recordsets of zone %s: %w", zone, err)
+	}
+	return resp.RecordSets, nil
+}
+
+// replaceRecordSets replaces the old recordsets with the new ones. Only recordsets which are added, changed or removed
+// are sent, one request each, so that other recordsets of the zone, including its SOA record, are never written.
+func replaceRecordSets(ctx context.Context, meta meta.Meta, logger log.Interface, zone string, old, new []dns.RecordSet) error {
+	client := inst.Client(meta)
+	newKeys := make(map[recordSetKey]struct{}, len(new))
+	for _, recordSet := range new {
+		newKeys[keyOf(recordSet)] = struct{}{}
+	}
+	oldByKey := make(map[recordSetKey]dns.RecordSet, len(old))
+	for _, recordSet := range old {
+		oldByKey[keyOf(recordSet)] = recordSet
+	}
+
+	for _, recordSet := range old {
+		if _, ok := newKeys[keyOf(recordSet)]; ok {
+			continue
+		}
+		err := retryRecordSetsConflict(ctx, meta, logger, zone, func() error {
+			return client.DeleteRecord(ctx, dns.DeleteRecordRequest{
+				Zone:       zone,
+				Name:       recordSet.Name,
+				RecordType: recordSet.Type,
+				RecLock:    []bool{false},
+			})
+		})
+		// a recordset removed outside of terraform is already gone
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to remove %s: %w", keyOf(recordSet), err)
+		}
+	}
+
+	for _, recordSet := range new {
+		record := &dns.RecordBody{Name: recordSet.Name, RecordType: recordSet.Type, TTL: recordSet.TTL, Target: recordSet.Rdata}
+		previous, ok := oldByKey[keyOf(recordSet)]
+		switch {
+		case ok && recordSetsEqual(zone, previous, recordSet):
+			continue
+		case ok:
+			err := retryRecordSetsConflict(ctx, meta, logger, zone, func() error {
+				return client.UpdateRecord(ctx, dns.UpdateRecordRequest{Record: record, Zone: zone, RecLock: []bool{false}})
+			})
+			if err != nil {
+				return fmt.Errorf("failed to change %s: %w", keyOf(recordSet), err)
+			}
+		default:
+			err := retryRecordSetsConflict(ctx, meta, logger, zone, func() error {
+				return client.CreateRecord(ctx, dns.CreateRecordRequest{Record: record, Zone: zone, RecLock: []bool{false}})
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add %s: %w", keyOf(recordSet), err)
+			}
+		}
+	}
+	return nil
+}
+
+// retryRecordSetsConflict calls f, holding the lock of the zone, and calls it again when it fails because of a concurrent modification of the zone
+func retryRecordSetsConflict(ctx context.Context, meta meta.Meta, logger log.Interface, zone string, f func() error) error {
+	lock := zoneLock(zone)
+	lock.Lock()
+	defer lock.Unlock()
+
+	retry := recordRetryFor(meta)
+	err := recordError(f())
+	for attempt := 1; errors.Is(err, ErrRecordConflict) && attempt <= retry.max; attempt++ {
+		logger.Debugf("Concurrency Conflict, retrying after %s", retry.backoff(attempt))
+		if err := retry.wait(ctx, attempt); err != nil {
+			return err
+		}
+		err = recordError(f())
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResDNSRecordSets(t *testing.T) {
+	soa := dns.RecordSet{Name: "exampleterraform.io", Type: "SOA", TTL: 86400, Rdata: []string{"a1-1.akam.net. hostmaster.exampleterraform.io. 1 3600 600 604800 300"}}
+	ns := dns.RecordSet{Name: "exampleterraform.io", Type: "NS", TTL: 86400, Rdata: []string{"a1-1.akam.net."}}
+	www := dns.RecordSet{Name: "www.exampleterraform.io", Type: "A", TTL: 300, Rdata: []string{"192.0.2.10", "192.0.2.11"}}
+	mx := dns.RecordSet{Name: "exampleterraform.io", Type: "MX", TTL: 300, Rdata: []string{"10 mail.exampleterraform.io."}}
+	updatedWWW := dns.RecordSet{Name: "www.exampleterraform.io", Type: "A", TTL: 600, Rdata: []string{"192.0.2.10", "192.0.2.11"}}
+	api := dns.RecordSet{Name: "api.exampleterraform.io", Type: "CNAME", TTL: 300, Rdata: []string{"www.exampleterraform.io."}}
+
+	client := &dns.Mock{}
+	// recordsets of the zone are updated by the mocked requests, the SOA and NS records are never sent
+	zoneState := &dns.GetRecordSetsResponse{RecordSets: []dns.RecordSet{soa, ns}}
+	setZone := func(recordSets ...dns.RecordSet) func(mock.Arguments) {
+		return func(mock.Arguments) {
+			zoneState.RecordSets = recordSets
+		}
+	}
+	record := func(recordSet dns.RecordSet) *dns.RecordBody {
+		return &dns.RecordBody{Name: recordSet.Name, RecordType: recordSet.Type, TTL: recordSet.TTL, Target: recordSet.Rdata}
+	}
+	client.On("GetRecordSets", mock.Anything, dns.GetRecordSetsRequest{
+		Zone:      "exampleterraform.io",
+		QueryArgs: &dns.RecordSetQueryArgs{ShowAll: true},
+	}).Return(zoneState, nil)
+	client.On("CreateRecordSets", mock.Anything, dns.CreateRecordSetsRequest{
+		Zone:       "exampleterraform.io",
+		RecordSets: &dns.RecordSets{RecordSets: []dns.RecordSet{mx, www}},
+	}).Run(setZone(soa, ns, mx, www)).Return(nil).Once()
+	// update
+	client.On("DeleteRecord", mock.Anything, dns.DeleteRecordRequest{
+		Zone: "exampleterraform.io", Name: mx.Name, RecordType: mx.Type, RecLock: []bool{false},
+	}).Run(setZone(soa, ns, www)).Return(nil).Once()
+	client.On("CreateRecord", mock.Anything, dns.CreateRecordRequest{
+		Record: record(api), Zone: "exampleterraform.io", RecLock: []bool{false},
+	}).Run(setZone(soa, ns, api, www)).Return(nil).Once()
+	client.On("UpdateRecord", mock.Anything, dns.UpdateRecordRequest{
+		Record: record(updatedWWW), Zone: "exampleterraform.io", RecLock: []bool{false},
+	}).Run(setZone(soa, ns, api, updatedWWW)).Return(nil).Once()
+	// delete
+	client.On("DeleteRecord", mock.Anything, dns.DeleteRecordRequest{
+		Zone: "exampleterraform.io", Name: api.Name, RecordType: api.Type, RecLock: []bool{false},
+	}).Run(setZone(soa, ns, updatedWWW)).Return(nil).Once()
+	client.On("DeleteRecord", mock.Anything, dns.DeleteRecordRequest{
+		Zone: "exampleterraform.io", Name: updatedWWW.Name, RecordType: updatedWWW.Type, RecLock: []bool{false},
+	}).Run(setZone(soa, ns)).Return(nil).Once()
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsRecordSets/create.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "id", "exampleterraform.io"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "recordset.#", "2"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.#", "2"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.0", "add exampleterraform.io MX"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.1", "add www.exampleterraform.io A"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsRecordSets/update.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "recordset.#", "2"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.#", "3"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.0", "add api.exampleterraform.io CNAME"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.1", "change www.exampleterraform.io A"),
+						resource.TestCheckResourceAttr("akamai_dns_recordsets.test", "changes.2", "remove exampleterraform.io MX"),
+					),
+				},
+			},
+		})
+	})
+
+	client.AssertExpectations(t)
+}
+
+func TestValidateRecordSets(t *testing.T) {
+	tests := map[string]struct {
+		recordSets []dns.RecordSet
+		withError  []string
+	}{
+		"valid recordsets": {
+			recordSets: []dns.RecordSet{
+				{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+				{Name: "example.com", Type: "TXT", TTL: 300, Rdata: []string{`"v=spf1 -all"`}},
+			},
+		},
+		"duplicated recordset": {
+			recordSets: []dns.RecordSet{
+				{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}},
+				{Name: "WWW.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.2"}},
+			},
+			withError: []string{"recordset www.example.com A is declared more than once"},
+		},
+		"invalid records": {
+			recordSets: []dns.RecordSet{
+				{Name: "www.example.net", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}},
+				{Name: "mail.example.com", Type: "MX", TTL: 300, Rdata: []string{"mx.example.com."}},
+			},
+			withError: []string{
+				"recordset www.example.net A does not belong to zone example.com",
+				"recordset mail.example.com MX: invalid zone file: line 1: MX record expects 2 fields (priority, target), got 1",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateRecordSets("example.com", test.recordSets)
+			if len(test.withError) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, msg := range test.withError {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestDiffRecordSets(t *testing.T) {
+	old := []dns.RecordSet{
+		{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "mail.example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mx"}},
+		{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}},
+	}
+	new := []dns.RecordSet{
+		{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.2", "192.0.2.1"}},
+		{Name: "mail.example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mx.example.com."}},
+		{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+		{Name: "old.example.com", Type: "CNAME", TTL: 600, Rdata: []string{"www.example.com."}},
+	}
+
+	assert.Equal(t, []string{
+		"add www.example.com AAAA",
+		"change old.example.com CNAME",
+	}, diffRecordSets("example.com", old, new))
+	assert.Equal(t, []string{
+		"remove old.example.com CNAME",
+		"remove www.example.com AAAA",
+	}, diffRecordSets("example.com", new, new[:2]))
+}
@@ -0,0 +1,281 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSTSIGKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSTSIGKeyCreate,
+		ReadContext:   resourceDNSTSIGKeyRead,
+		UpdateContext: resourceDNSTSIGKeyUpdate,
+		DeleteContext: resourceDNSTSIGKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSTSIGKeyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the TSIG key",
+			},
+			"algorithm": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Algorithm of the TSIG key, e.g. 'hmac-sha256'",
+			},
+			"secret": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Base64 encoded secret of the TSIG key",
+			},
+			"zones": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Names of the secondary zones which use the key for zone transfers",
+			},
+			"other_zones": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Names of the zones not listed in 'zones' which also use the key, for example those the key was rotated in",
+			},
+		},
+	}
+}
+
+func resourceDNSTSIGKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSTSIGKeyCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	key, err := tsigKeyFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneSet, err := tf.GetSetValue("zones", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zones := tf.SetToStringSlice(zoneSet)
+	sort.Strings(zones)
+	logger.WithFields(log.Fields{"key": key.Name, "zones": zones}).Info("TSIG Key Create")
+
+	if err := updateTSIGKeyZones(ctx, inst.Client(meta), key, zones); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(key.Name)
+
+	return resourceDNSTSIGKeyRead(ctx, d, m)
+}
+
+func resourceDNSTSIGKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSTSIGKeyRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	key, err := tsigKeyFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := inst.Client(meta).GetTSIGKeyZones(ctx, dns.GetTSIGKeyZonesRequest{TsigKey: key})
+	if err != nil && !isNotFound(err) {
+		return diag.Errorf("failed to read zones using TSIG key %s: %s", key.Name, err)
+	}
+	if err != nil || len(resp.Zones) == 0 {
+		logger.WithField("key", key.Name).Warn("TSIG key is not used by any zone, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	zones, otherZones := splitTSIGKeyZones(resp.Zones, d.Get("zones").(*schema.Set))
+	if err := tf.SetAttrs(d, map[string]interface{}{"zones": zones, "other_zones": otherZones}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDNSTSIGKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSTSIGKeyUpdate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+
+	key, err := tsigKeyFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// only zones known to the previous state lose the key, zones which use it besides them are left intact
+	oldZones, newZones := d.GetChange("zones")
+	removed := oldZones.(*schema.Set).Difference(newZones.(*schema.Set))
+	zones := schema.NewSet(schema.HashString, newZones.(*schema.Set).List())
+
+	// a rotated key replaces the previous one in every zone using it, not only in those known to the state
+	if d.HasChanges("name", "algorithm", "secret") {
+		oldName, _ := d.GetChange("name")
+		oldAlgorithm, _ := d.GetChange("algorithm")
+		oldSecret, _ := d.GetChange("secret")
+		oldKey := &dns.TSIGKey{Name: oldName.(string), Algorithm: oldAlgorithm.(string), Secret: oldSecret.(string)}
+
+		resp, err := client.GetTSIGKeyZones(ctx, dns.GetTSIGKeyZonesRequest{TsigKey: oldKey})
+		if err != nil && !isNotFound(err) {
+			return diag.Errorf("failed to read zones using TSIG key %s: %s", oldKey.Name, err)
+		}
+		if err == nil {
+			for _, zone := range resp.Zones {
+				if !removed.Contains(zone) {
+					zones.Add(zone)
+				}
+			}
+		}
+	}
+
+	rotated := tf.SetToStringSlice(zones)
+	sort.Strings(rotated)
+	logger.WithFields(log.Fields{"key": key.Name, "zones": rotated, "removed": removed.List()}).Info("TSIG Key Update")
+
+	if err := updateTSIGKeyZones(ctx, client, key, rotated); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, zone := range tf.SetToStringSlice(removed) {
+		if err := deleteZoneTSIGKey(ctx, client, zone); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(key.Name)
+
+	return resourceDNSTSIGKeyRead(ctx, d, m)
+}
+
+func resourceDNSTSIGKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSTSIGKeyDelete")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zoneSet, err := tf.GetSetValue("zones", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zones := tf.SetToStringSlice(zoneSet)
+	sort.Strings(zones)
+	logger.WithFields(log.Fields{"key": d.Id(), "zones": zones}).Info("TSIG Key Delete")
+
+	for _, zone := range zones {
+		if err := deleteZoneTSIGKey(ctx, inst.Client(meta), zone); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceDNSTSIGKeyImport imports the TSIG key used by the secondary zone given as the import ID.
+// The API does not return the secret of a key by its name, so a zone using the key is needed.
+func resourceDNSTSIGKeyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSTSIGKeyImport")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	logger.WithField("zone", zone).Info("TSIG Key Import")
+
+	resp, err := inst.Client(meta).GetTSIGKey(ctx, dns.GetTSIGKeyRequest{Zone: zone})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TSIG key of zone %s: %w", zone, err)
+	}
+	if err := tf.SetAttrs(d, map[string]interface{}{
+		"name":      resp.Name,
+		"algorithm": resp.Algorithm,
+		"secret":    resp.Secret,
+	}); err != nil {
+		return nil, err
+	}
+	d.SetId(resp.Name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func tsigKeyFromData(d tf.ResourceDataFetcher) (*dns.TSIGKey, error) {
+	name, err := tf.GetStringValue("name", d)
+	if err != nil {
+		return nil, err
+	}
+	algorithm, err := tf.GetStringValue("algorithm", d)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := tf.GetStringValue("secret", d)
+	if err != nil {
+		return nil, err
+	}
+	return &dns.TSIGKey{Name: name, Algorithm: algorithm, Secret: secret}, nil
+}
+
+// splitTSIGKeyZones splits the zones using the key into the managed ones and the others. When no zones are managed yet,
+// as after an import, all the zones are managed.
+func splitTSIGKeyZones(keyZones []string, managed *schema.Set) (zones, otherZones []string) {
+	if managed.Len() == 0 {
+		return keyZones, nil
+	}
+	for _, zone := range keyZones {
+		if managed.Contains(zone) {
+			zones = append(zones, zone)
+		} else {
+			otherZones = append(otherZones, zone)
+		}
+	}
+	return zones, otherZones
+}
+
+// updateTSIGKeyZones sets the key as the TSIG key of all the zones in a single request
+func updateTSIGKeyZones(ctx context.Context, client dns.DNS, key *dns.TSIGKey, zones []string) error {
+	if err := client.UpdateTSIGKeyBulk(ctx, dns.UpdateTSIGKeyBulkRequest{
+		TSIGKeyBulk: &dns.TSIGKeyBulkPost{Key: key, Zones: zones},
+	}); err != nil {
+		return fmt.Errorf("failed to set TSIG key %s on zones %v: %w", key.Name, zones, err)
+	}
+	return nil
+}
+
+// deleteZoneTSIGKey removes the TSIG key of the zone, a zone which was already deleted is ignored
+func deleteZoneTSIGKey(ctx context.Context, client dns.DNS, zone string) error {
+	if err := client.DeleteTSIGKey(ctx, dns.DeleteTSIGKeyRequest{Zone: zone}); err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to remove TSIG key of zone %s: %w", zone, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResDNSTSIGKey(t *testing.T) {
+	resourceName := "akamai_dns_tsig_key.test"
+	key := &dns.TSIGKey{Name: "transfer.example.com", Algorithm: "hmac-sha256", Secret: "c2VjcmV0MQ=="}
+	rotated := &dns.TSIGKey{Name: "transfer.example.com", Algorithm: "hmac-sha256", Secret: "c2VjcmV0Mg=="}
+
+	client := &dns.Mock{}
+	client.On("UpdateTSIGKeyBulk", mock.Anything, dns.UpdateTSIGKeyBulkRequest{
+		TSIGKeyBulk: &dns.TSIGKeyBulkPost{Key: key, Zones: []string{"secondary1.example.com", "secondary2.example.com"}},
+	}).Return(nil).Once()
+	client.On("GetTSIGKeyZones", mock.Anything, dns.GetTSIGKeyZonesRequest{TsigKey: key}).Return(&dns.GetTSIGKeyZonesResponse{
+		Zones: []string{"secondary1.example.com", "secondary2.example.com"},
+	}, nil)
+
+	// rotation of the key drops the second zone
+	client.On("UpdateTSIGKeyBulk", mock.Anything, dns.UpdateTSIGKeyBulkRequest{
+		TSIGKeyBulk: &dns.TSIGKeyBulkPost{Key: rotated, Zones: []string{"secondary1.example.com"}},
+	}).Return(nil).Once()
+	client.On("DeleteTSIGKey", mock.Anything, dns.DeleteTSIGKeyRequest{Zone: "secondary2.example.com"}).Return(nil).Once()
+	client.On("GetTSIGKeyZones", mock.Anything, dns.GetTSIGKeyZonesRequest{TsigKey: rotated}).Return(&dns.GetTSIGKeyZonesResponse{
+		Zones: []string{"secondary1.example.com"},
+	}, nil)
+
+	client.On("GetTSIGKey", mock.Anything, dns.GetTSIGKeyRequest{Zone: "secondary1.example.com"}).Return(&dns.GetTSIGKeyResponse{
+		TSIGKey: *rotated, ZoneCount: 1,
+	}, nil).Once()
+	client.On("DeleteTSIGKey", mock.Anything, dns.DeleteTSIGKeyRequest{Zone: "secondary1.example.com"}).Return(nil).Once()
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsTSIGKey/create.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", "transfer.example.com"),
+						resource.TestCheckResourceAttr(resourceName, "secret", "c2VjcmV0MQ=="),
+						resource.TestCheckResourceAttr(resourceName, "zones.#", "2"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsTSIGKey/rotate.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", "transfer.example.com"),
+						resource.TestCheckResourceAttr(resourceName, "secret", "c2VjcmV0Mg=="),
+						resource.TestCheckResourceAttr(resourceName, "zones.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "zones.*", "secondary1.example.com"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportState:       true,
+					ImportStateId:     "secondary1.example.com",
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	client.AssertExpectations(t)
+}
+
+func TestResDNSTSIGKeyOtherZones(t *testing.T) {
+	resourceName := "akamai_dns_tsig_key.test"
+	key := &dns.TSIGKey{Name: "transfer.example.com", Algorithm: "hmac-sha256", Secret: "c2VjcmV0MQ=="}
+	rotated := &dns.TSIGKey{Name: "transfer.example.com", Algorithm: "hmac-sha256", Secret: "c2VjcmV0Mg=="}
+
+	// the key is also used by a zone not managed by the resource
+	client := &dns.Mock{}
+	client.On("UpdateTSIGKeyBulk", mock.Anything, dns.UpdateTSIGKeyBulkRequest{
+		TSIGKeyBulk: &dns.TSIGKeyBulkPost{Key: key, Zones: []string{"secondary1.example.com", "secondary2.example.com"}},
+	}).Return(nil).Once()
+	client.On("GetTSIGKeyZones", mock.Anything, dns.GetTSIGKeyZonesRequest{TsigKey: key}).Return(&dns.GetTSIGKeyZonesResponse{
+		Zones: []string{"secondary1.example.com", "secondary2.example.com", "secondary3.example.com"},
+	}, nil)
+
+	// rotation replaces the key in the other zone too, but removes it only from the dropped managed zone
+	client.On("UpdateTSIGKeyBulk", mock.Anything, dns.UpdateTSIGKeyBulkRequest{
+		TSIGKeyBulk: &dns.TSIGKeyBulkPost{Key: rotated, Zones: []string{"secondary1.example.com", "secondary3.example.com"}},
+	}).Return(nil).Once()
+	client.On("DeleteTSIGKey", mock.Anything, dns.DeleteTSIGKeyRequest{Zone: "secondary2.example.com"}).Return(nil).Once()
+	client.On("GetTSIGKeyZones", mock.Anything, dns.GetTSIGKeyZonesRequest{TsigKey: rotated}).Return(&dns.GetTSIGKeyZonesResponse{
+		Zones: []string{"secondary1.example.com", "secondary3.example.com"},
+	}, nil)
+
+	client.On("DeleteTSIGKey", mock.Anything, dns.DeleteTSIGKeyRequest{Zone: "secondary1.example.com"}).Return(nil).Once()
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsTSIGKey/create.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "zones.#", "2"),
+						resource.TestCheckResourceAttr(resourceName, "other_zones.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "other_zones.*", "secondary3.example.com"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResDnsTSIGKey/rotate.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "secret", "c2VjcmV0Mg=="),
+						resource.TestCheckResourceAttr(resourceName, "zones.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "zones.*", "secondary1.example.com"),
+						resource.TestCheckResourceAttr(resourceName, "other_zones.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "other_zones.*", "secondary3.example.com"),
+					),
+				},
+			},
+		})
+	})
+
+	client.AssertExpectations(t)
+}
+
+func TestSplitTSIGKeyZones(t *testing.T) {
+	keyZones := []string{"secondary1.example.com", "secondary2.example.com", "secondary3.example.com"}
+
+	zones, otherZones := splitTSIGKeyZones(keyZones, schema.NewSet(schema.HashString, []interface{}{"secondary1.example.com", "secondary4.example.com"}))
+	assert.Equal(t, []string{"secondary1.example.com"}, zones)
+	assert.Equal(t, []string{"secondary2.example.com", "secondary3.example.com"}, otherZones)
+
+	// after an import no zones are managed yet
+	zones, otherZones = splitTSIGKeyZones(keyZones, schema.NewSet(schema.HashString, nil))
+	assert.Equal(t, keyZones, zones)
+	assert.Empty(t, otherZones)
+}
@@ -83,6 +83,8 @@ func resourceDNSv2Zone() *schema.Resource {
 			"tsig_key": {
 				Type:     schema.TypeList,
 				Optional: true,
+				// the key may be managed by akamai_dns_tsig_key instead
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
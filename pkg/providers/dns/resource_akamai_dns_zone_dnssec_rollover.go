@@ -0,0 +1,157 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// dnssecRolloverPollMinimum is the minimum interval between DNSSEC status checks during a key rollover
+	dnssecRolloverPollMinimum = 10 * time.Second
+	// dnssecRolloverPollInterval is the interval between DNSSEC status checks during a key rollover
+	dnssecRolloverPollInterval = dnssecRolloverPollMinimum
+	// dnssecRolloverTimeout is the default time to wait for the new keys of a rollover
+	dnssecRolloverTimeout = 30 * time.Minute
+
+	// ErrDNSSECRolloverTimeout is returned when the new keys of a rollover are not generated in time
+	ErrDNSSECRolloverTimeout = errors.New("timed out waiting for new DNSSEC keys")
+)
+
+func resourceDNSZoneDNSSecRollover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneDNSSecRolloverCreate,
+		ReadContext:   schema.NoopContext,
+		DeleteContext: resourceDNSZoneDNSSecRolloverDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: &dnssecRolloverTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the sign-and-serve zone whose DNSSEC keys are rolled over",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which start another rollover when changed",
+			},
+			"new_ds_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "DS records of the new keys, as 'keytag algorithm digest_type digest', to be published in the parent zone",
+			},
+			"new_dnskey_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNSKEY records of the new keys",
+			},
+		},
+	}
+}
+
+func resourceDNSZoneDNSSecRolloverCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneDNSSecRolloverCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithField("zone", zone).Info("DNSSEC Key Rollover")
+
+	status, err := getDNSSecStatus(ctx, inst.Client(meta), zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// a rollover in progress is followed instead of starting another one
+	if status.NewRecords == nil {
+		if err := dnssecKeysClientFor(meta).RolloverKeys(ctx, zone); err != nil {
+			return diag.Errorf("failed to start DNSSEC key rollover of zone %s: %s", zone, err)
+		}
+		if status, err = waitForDNSSecRollover(ctx, inst.Client(meta), zone); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		logger.WithField("zone", zone).Info("DNSSEC key rollover is already in progress")
+	}
+
+	dsRecords, err := parseDSRecords(status.NewRecords.DSRecord)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newDSRecords := make([]string, 0, len(dsRecords))
+	for _, ds := range dsRecords {
+		newDSRecords = append(newDSRecords, fmt.Sprintf("%d %d %d %s", ds.KeyTag, ds.Algorithm, ds.DigestType, ds.Digest))
+	}
+	if err := tf.SetAttrs(d, map[string]interface{}{
+		"new_ds_records":    newDSRecords,
+		"new_dnskey_record": status.NewRecords.DNSKeyRecord,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(zone)
+
+	return nil
+}
+
+func resourceDNSZoneDNSSecRolloverDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneDNSSecRolloverDelete")
+
+	// a rollover cannot be reverted, so the resource is only removed from state
+	logger.WithField("zone", d.Id()).Info("DNSSEC Key Rollover Delete: keys of the zone are left unchanged")
+
+	return nil
+}
+
+func getDNSSecStatus(ctx context.Context, client dns.DNS, zone string) (*dns.SecStatus, error) {
+	resp, err := client.GetZonesDNSSecStatus(ctx, dns.GetZonesDNSSecStatusRequest{Zones: []string{zone}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DNSSEC status of zone %s: %w", zone, err)
+	}
+	if len(resp.DNSSecStatuses) == 0 {
+		return nil, fmt.Errorf("no DNSSEC status for zone: %s, make sure that zone has DNSSEC enabled", zone)
+	}
+	return &resp.DNSSecStatuses[0], nil
+}
+
+// waitForDNSSecRollover polls the DNSSEC status of the zone until the new keys of the rollover are reported
+func waitForDNSSecRollover(ctx context.Context, client dns.DNS, zone string) (*dns.SecStatus, error) {
+	for {
+		status, err := getDNSSecStatus(ctx, client, zone)
+		if err != nil {
+			return nil, err
+		}
+		if status.NewRecords != nil {
+			return status, nil
+		}
+
+		select {
+		case <-time.After(tf.MaxDuration(dnssecRolloverPollInterval, dnssecRolloverPollMinimum)):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w of zone %s", ErrDNSSECRolloverTimeout, zone)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
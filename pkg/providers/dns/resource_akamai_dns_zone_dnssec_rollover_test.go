@@ -0,0 +1,53 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResDNSZoneDNSSecRollover(t *testing.T) {
+	dnssecRolloverPollMinimum, dnssecRolloverPollInterval = time.Millisecond, time.Millisecond
+	request := dns.GetZonesDNSSecStatusRequest{Zones: []string{"test.zone.net"}}
+	current := dns.SecRecords{DNSKeyRecord: testDNSKeyRecord, DSRecord: "1 13 2 AA"}
+
+	client := &dns.Mock{}
+	dnssecKeys := &mockDNSSecKeys{}
+	client.On("GetZonesDNSSecStatus", mock.Anything, request).Return(&dns.GetZonesDNSSecStatusResponse{
+		DNSSecStatuses: []dns.SecStatus{{Zone: "test.zone.net", CurrentRecords: current}},
+	}, nil).Twice()
+	dnssecKeys.On("RolloverKeys", mock.Anything, "test.zone.net").Return(nil).Once()
+	client.On("GetZonesDNSSecStatus", mock.Anything, request).Return(&dns.GetZonesDNSSecStatusResponse{
+		DNSSecStatuses: []dns.SecStatus{{
+			Zone:           "test.zone.net",
+			CurrentRecords: current,
+			NewRecords:     &dns.SecRecords{DNSKeyRecord: testDNSKeyRecord, DSRecord: testDSRecord},
+		}},
+	}, nil).Once()
+
+	useClient(client, func() {
+		useDNSSecKeys(dnssecKeys, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsZoneDnsSecRollover/create.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_dns_zone_dnssec_rollover.test", "id", "test.zone.net"),
+							resource.TestCheckResourceAttr("akamai_dns_zone_dnssec_rollover.test", "new_ds_records.#", "1"),
+							resource.TestCheckResourceAttr("akamai_dns_zone_dnssec_rollover.test", "new_ds_records.0", "60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+							resource.TestCheckResourceAttr("akamai_dns_zone_dnssec_rollover.test", "new_dnskey_record", testDNSKeyRecord),
+						),
+					},
+				},
+			})
+		})
+	})
+
+	client.AssertExpectations(t)
+	dnssecKeys.AssertExpectations(t)
+}
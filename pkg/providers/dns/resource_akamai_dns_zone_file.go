@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneFileCreate,
+		ReadContext:   resourceDNSZoneFileRead,
+		UpdateContext: resourceDNSZoneFileUpdate,
+		DeleteContext: resourceDNSZoneFileDelete,
+		CustomizeDiff: validateZoneFileContent,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSZoneFileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the zone whose records are managed",
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				DiffSuppressFunc: func(_, old, new string, d *schema.ResourceData) bool {
+					return zoneFilesEqual(d.Get("zone").(string), old, new)
+				},
+				Description: "All records of the zone in the RFC 1035 master file format. The $ORIGIN and $TTL directives are supported",
+			},
+			"record_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records in the zone, excluding read-only AKAMAITLC records",
+			},
+		},
+	}
+}
+
+// validateZoneFileContent implements a schema.CustomizeDiffFunc for akamai_dns_zone_file resource.
+//
+// It parses the content and validates every record with the same rules as akamai_dns_record.
+func validateZoneFileContent(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("zone") {
+		return nil
+	}
+	_, err := parseAndValidateZoneFile(d.Get("zone").(string), d.Get("content").(string))
+	return err
+}
+
+func parseAndValidateZoneFile(zone, content string) ([]zoneFileRecord, error) {
+	records, err := parseZoneFile(zone, content)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, record := range records {
+		if err := record.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return records, nil
+}
+
+func resourceDNSZoneFileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneFileCreate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone, err := tf.GetStringValue("zone", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.WithField("zone", zone).Info("Zone File Create")
+
+	if err := uploadZoneFile(ctx, meta, d, zone); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(zone)
+
+	return resourceDNSZoneFileRead(ctx, d, m)
+}
+
+func resourceDNSZoneFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneFileRead")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	logger.WithField("zone", zone).Debug("Zone File Read")
+
+	exported, err := inst.Client(meta).GetMasterZoneFile(ctx, dns.GetMasterZoneFileRequest{Zone: zone})
+	if err != nil {
+		return diag.Errorf("failed to read zone file of %s: %s", zone, err)
+	}
+	records, err := parseZoneFile(zone, exported)
+	if err != nil {
+		return diag.Errorf("failed to parse zone file of %s: %s", zone, err)
+	}
+
+	attrs := map[string]interface{}{
+		"zone":         zone,
+		"record_count": len(zoneFileKeys(records)),
+	}
+	// the configured content is kept unless records were changed outside of terraform
+	if content := d.Get("content").(string); !zoneFilesEqual(zone, content, exported) {
+		logger.WithField("zone", zone).Debug("Zone records differ from the configured content")
+		attrs["content"] = formatZoneFile(records)
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDNSZoneFileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneFileUpdate")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	zone := d.Id()
+	logger.WithField("zone", zone).Info("Zone File Update")
+
+	if err := uploadZoneFile(ctx, meta, d, zone); err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	return resourceDNSZoneFileRead(ctx, d, m)
+}
+
+func resourceDNSZoneFileDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneFileDelete")
+
+	// A zone cannot be left without records, so they stay in place and the resource is only removed from state
+	logger.WithField("zone", d.Id()).Warn("Zone File Delete: records of the zone are left unchanged")
+
+	return nil
+}
+
+func resourceDNSZoneFileImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := meta.Must(m)
+	logger := meta.Log("AkamaiDNS", "resourceDNSZoneFileImport")
+	logger.WithField("zone", d.Id()).Info("Zone File Import")
+
+	if diags := resourceDNSZoneFileRead(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failed to import zone file of %s: %s", d.Id(), diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// uploadZoneFile validates the configured content and replaces all records of the zone with it
+func uploadZoneFile(ctx context.Context, meta meta.Meta, d *schema.ResourceData, zone string) error {
+	content, err := tf.GetStringValue("content", d)
+	if err != nil {
+		return err
+	}
+	if _, err := parseAndValidateZoneFile(zone, content); err != nil {
+		return err
+	}
+
+	if err := inst.Client(meta).PostMasterZoneFile(ctx, dns.PostMasterZoneFileRequest{
+		Zone:     zone,
+		FileData: content,
+	}); err != nil {
+		return fmt.Errorf("failed to upload zone file of %s: %w", zone, err)
+	}
+	return nil
+}
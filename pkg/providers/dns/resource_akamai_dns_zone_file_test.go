@@ -0,0 +1,70 @@
+package dns
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResDNSZoneFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/TestResDnsZoneFile/zone.db")
+	require.NoError(t, err)
+	exported := "exampleterraform.io.\t300\tIN\tSOA\ta1-1.akam.net. hostmaster.exampleterraform.io. 2 3600 600 604800 300\n" +
+		"exampleterraform.io.\t300\tIN\tNS\ta1-1.akam.net.\n" +
+		"exampleterraform.io.\t300\tIN\tAKAMAITLC\t1 192.0.2.1\n" +
+		"www.exampleterraform.io.\t300\tIN\tA\t192.0.2.10\n"
+
+	t.Run("zone file lifecycle", func(t *testing.T) {
+		client := &dns.Mock{}
+		client.On("PostMasterZoneFile",
+			mock.Anything,
+			dns.PostMasterZoneFileRequest{Zone: "exampleterraform.io", FileData: string(content)},
+		).Return(nil).Once()
+		client.On("GetMasterZoneFile",
+			mock.Anything,
+			dns.GetMasterZoneFileRequest{Zone: "exampleterraform.io"},
+		).Return(exported, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsZoneFile/create.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_dns_zone_file.test", "id", "exampleterraform.io"),
+							resource.TestCheckResourceAttr("akamai_dns_zone_file.test", "content", string(content)),
+							resource.TestCheckResourceAttr("akamai_dns_zone_file.test", "record_count", "3"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid record is reported at plan", func(t *testing.T) {
+		client := &dns.Mock{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResDnsZoneFile/invalid.tf"),
+						ExpectError: regexp.MustCompile("line 1: AAAA record www.exampleterraform.io: target '192.0.2.10' is not"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
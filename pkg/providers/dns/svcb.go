@@ -0,0 +1,194 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/svcparams"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// svcParamAttributes are the attributes holding the service parameters of SVCB and HTTPS records one by one,
+// as an alternative to svc_params
+var svcParamAttributes = []string{
+	"svc_mandatory",
+	"svc_alpn",
+	"svc_no_default_alpn",
+	"svc_port",
+	"svc_ipv4hint",
+	"svc_ech",
+	"svc_ipv6hint",
+}
+
+// serviceRecordAttributes are all attributes of SVCB and HTTPS records
+var serviceRecordAttributes = append([]string{"svc_priority", "target_name", "svc_params"}, svcParamAttributes...)
+
+// hasSvcParamAttributes tells if the service parameters are set with the svc_* attributes
+func hasSvcParamAttributes(rd tf.ResourceDataFetcher) bool {
+	for _, name := range svcParamAttributes {
+		if _, ok := rd.GetOk(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceParams returns the validated service parameters of an SVCB or HTTPS record, or nil when none are set
+func serviceParams(rd tf.ResourceDataFetcher) (*svcparams.Params, error) {
+	if !hasSvcParamAttributes(rd) {
+		params, err := tf.GetStringValue("svc_params", rd)
+		if err != nil {
+			if errors.Is(err, tf.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return svcparams.Parse(params)
+	}
+
+	var err error
+	p := &svcparams.Params{}
+	if p.Mandatory, err = tf.GetTypedListValue[string]("svc_mandatory", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if p.ALPN, err = tf.GetTypedListValue[string]("svc_alpn", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if p.NoDefaultALPN, err = tf.GetBoolValue("svc_no_default_alpn", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	port, err := tf.GetIntValue("svc_port", rd)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		p.Port = &port
+	}
+	if p.IPv4Hint, err = tf.GetTypedListValue[string]("svc_ipv4hint", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if p.ECH, err = tf.GetStringValue("svc_ech", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	if p.IPv6Hint, err = tf.GetTypedListValue[string]("svc_ipv6hint", rd); err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+	return p, p.Validate()
+}
+
+// svcParamsString returns the service parameters of an SVCB or HTTPS record in the canonical presentation format
+func svcParamsString(rd tf.ResourceDataFetcher) (string, error) {
+	params, err := serviceParams(rd)
+	if err != nil || params == nil {
+		return "", err
+	}
+	return params.String(), nil
+}
+
+// checkServiceParams enforces the rules of RFC 9460 on the priority, target and service parameters of an SVCB or HTTPS record
+func checkServiceParams(rd tf.ResourceDataFetcher, rtype string) error {
+	pri, err := tf.GetIntValue("svc_priority", rd)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return err
+	}
+	tname, err := tf.GetStringValue("target_name", rd)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return err
+	}
+	params, err := svcParamsString(rd)
+	if err != nil {
+		return fmt.Errorf("configuration of service parameters for %s is invalid: %w", rtype, err)
+	}
+
+	if pri < 0 || pri > 65535 {
+		return fmt.Errorf("configuration argument svc_priority must be positive int for %s", rtype)
+	}
+
+	if tname == "" {
+		return fmt.Errorf("configuration argument target_name must be set for %s", rtype)
+	}
+
+	if params == "" && pri > 0 {
+		return fmt.Errorf("configuration argument svc_params must be set for %s", rtype)
+	}
+
+	if pri == 0 && params != "" {
+		return fmt.Errorf("configuration argument svc_params cannot be set for %s if svc_priority is zero", rtype)
+	}
+
+	return nil
+}
+
+// validateServiceRecordDiff implements a schema.CustomizeDiffFunc for akamai_dns_record resource.
+//
+// It validates the service parameters of SVCB and HTTPS records during plan.
+func validateServiceRecordDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	recordType := d.Get("recordtype").(string)
+	if recordType != RRTypeSvcb && recordType != RRTypeHTTPS {
+		return nil
+	}
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	for _, name := range serviceRecordAttributes {
+		if !config.GetAttr(name).IsWhollyKnown() {
+			return nil
+		}
+	}
+	return checkServiceParams(d, recordType)
+}
+
+// setServiceParams sets the service parameters read from the API. They are set in svc_params when the record
+// is configured with it, or when they cannot be represented with the svc_* attributes
+func setServiceParams(d *schema.ResourceData, rawParams string) error {
+	attrs := map[string]interface{}{"svc_params": rawParams}
+	if d.Get("svc_params").(string) == "" || hasSvcParamAttributes(d) {
+		if params, err := svcparams.Parse(rawParams); err == nil && len(params.Generic) == 0 {
+			port := 0
+			if params.Port != nil {
+				port = *params.Port
+			}
+			attrs = map[string]interface{}{
+				"svc_params":          "",
+				"svc_mandatory":       params.Mandatory,
+				"svc_alpn":            params.ALPN,
+				"svc_no_default_alpn": params.NoDefaultALPN,
+				"svc_port":            port,
+				"svc_ipv4hint":        params.IPv4Hint,
+				"svc_ech":             params.ECH,
+				"svc_ipv6hint":        params.IPv6Hint,
+			}
+		}
+	}
+	return tf.SetAttrs(d, attrs)
+}
+
+// dnsRecordSvcParamsSuppress suppresses differences of service parameters which are equal in the canonical presentation format
+func dnsRecordSvcParamsSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	oldParams, err := svcparams.Canonical(old)
+	if err != nil {
+		return false
+	}
+	newParams, err := svcparams.Canonical(new)
+	if err != nil {
+		return false
+	}
+	return oldParams == newParams
+}
+
+// dnsRecordAddressSuppress suppresses differences of IP addresses written in different notations
+func dnsRecordAddressSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	oldAddr, err := netip.ParseAddr(old)
+	if err != nil {
+		return false
+	}
+	newAddr, err := netip.ParseAddr(new)
+	if err != nil {
+		return false
+	}
+	return oldAddr == newAddr
+}
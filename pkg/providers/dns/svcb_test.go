@@ -0,0 +1,146 @@
+package dns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/dns/internal/svcparams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcParamsString(t *testing.T) {
+	tests := map[string]struct {
+		attrs     map[string]interface{}
+		expected  string
+		withError string
+	}{
+		"svc_params are canonicalized": {
+			attrs:    map[string]interface{}{"svc_params": `port="8443" alpn=h2,h3`},
+			expected: `alpn="h2,h3" port=8443`,
+		},
+		"svc attributes": {
+			attrs: map[string]interface{}{
+				"svc_mandatory":       []interface{}{"port", "alpn"},
+				"svc_alpn":            []interface{}{"h3", "h2"},
+				"svc_no_default_alpn": true,
+				"svc_port":            8443,
+				"svc_ipv4hint":        []interface{}{"192.0.2.1"},
+				"svc_ipv6hint":        []interface{}{"2001:db8:0:0:0:0:0:1"},
+			},
+			expected: `mandatory=alpn,port alpn="h3,h2" no-default-alpn port=8443 ipv4hint=192.0.2.1 ipv6hint=2001:db8::1`,
+		},
+		"no service parameters": {
+			attrs:    map[string]interface{}{},
+			expected: "",
+		},
+		"mandatory key is not set": {
+			attrs:     map[string]interface{}{"svc_mandatory": []interface{}{"ech"}, "svc_alpn": []interface{}{"h2"}},
+			withError: "mandatory key ech is not set",
+		},
+		"no-default-alpn without alpn": {
+			attrs:     map[string]interface{}{"svc_params": "no-default-alpn port=443"},
+			withError: "no-default-alpn requires alpn to be set",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := resourceDNSv2Record().Data(nil)
+			for key, value := range test.attrs {
+				require.NoError(t, d.Set(key, value))
+			}
+
+			params, err := svcParamsString(d)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, svcparams.ErrInvalid))
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, params)
+		})
+	}
+}
+
+func TestCheckServiceParams(t *testing.T) {
+	tests := map[string]struct {
+		attrs     map[string]interface{}
+		withError string
+	}{
+		"service mode": {
+			attrs: map[string]interface{}{"svc_priority": 1, "target_name": ".", "svc_alpn": []interface{}{"h2"}},
+		},
+		"alias mode": {
+			attrs: map[string]interface{}{"svc_priority": 0, "target_name": "svc.example.com."},
+		},
+		"alias mode with parameters": {
+			attrs:     map[string]interface{}{"svc_priority": 0, "target_name": "svc.example.com.", "svc_port": 8443},
+			withError: "svc_params cannot be set for HTTPS if svc_priority is zero",
+		},
+		"service mode without parameters": {
+			attrs:     map[string]interface{}{"svc_priority": 1, "target_name": "."},
+			withError: "svc_params must be set for HTTPS",
+		},
+		"invalid parameters": {
+			attrs:     map[string]interface{}{"svc_priority": 1, "target_name": ".", "svc_params": "alpn=h2 alpn=h3"},
+			withError: "key alpn is repeated",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := resourceDNSv2Record().Data(nil)
+			for key, value := range test.attrs {
+				require.NoError(t, d.Set(key, value))
+			}
+
+			err := checkServiceParams(d, RRTypeHTTPS)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetServiceParams(t *testing.T) {
+	raw := `alpn="h2,h3" port=8443 ipv6hint=2001:db8::1`
+
+	t.Run("svc attributes are set when svc_params are not configured", func(t *testing.T) {
+		d := resourceDNSv2Record().Data(nil)
+		require.NoError(t, setServiceParams(d, raw))
+
+		assert.Equal(t, "", d.Get("svc_params"))
+		assert.Equal(t, []interface{}{"h2", "h3"}, d.Get("svc_alpn"))
+		assert.Equal(t, 8443, d.Get("svc_port"))
+		assert.Equal(t, []interface{}{"2001:db8::1"}, d.Get("svc_ipv6hint"))
+	})
+
+	t.Run("svc_params are kept when configured", func(t *testing.T) {
+		d := resourceDNSv2Record().Data(nil)
+		require.NoError(t, d.Set("svc_params", `port=8443 alpn=h2,h3`))
+		require.NoError(t, setServiceParams(d, raw))
+
+		assert.Equal(t, raw, d.Get("svc_params"))
+		assert.Empty(t, d.Get("svc_alpn"))
+	})
+
+	t.Run("generic keys are kept in svc_params", func(t *testing.T) {
+		d := resourceDNSv2Record().Data(nil)
+		require.NoError(t, setServiceParams(d, `alpn=h2 key667=hello`))
+
+		assert.Equal(t, `alpn=h2 key667=hello`, d.Get("svc_params"))
+		assert.Empty(t, d.Get("svc_alpn"))
+	})
+}
+
+func TestDNSRecordSvcParamsSuppress(t *testing.T) {
+	assert.True(t, dnsRecordSvcParamsSuppress("", `alpn="h2,h3" port=443`, `port=443 alpn=h2,h3`, nil))
+	assert.False(t, dnsRecordSvcParamsSuppress("", `alpn="h2,h3"`, `alpn="h3,h2"`, nil))
+	assert.False(t, dnsRecordSvcParamsSuppress("", `alpn=h2`, `alpn=h2 alpn=h3`, nil))
+
+	assert.True(t, dnsRecordAddressSuppress("", "2001:db8::1", "2001:0db8:0:0:0:0:0:1", nil))
+	assert.False(t, dnsRecordAddressSuppress("", "2001:db8::1", "2001:db8::2", nil))
+}
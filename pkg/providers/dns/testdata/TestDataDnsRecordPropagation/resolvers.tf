@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_dns_record_propagation" "test" {
+  zone           = "example.com"
+  name           = "www.example.com"
+  recordtype     = "A"
+  expected_rdata = ["192.0.2.1"]
+  resolvers      = ["%s"]
+}
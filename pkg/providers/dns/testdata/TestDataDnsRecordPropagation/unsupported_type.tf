@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_dns_record_propagation" "test" {
+  zone           = "example.com"
+  name           = "example.com"
+  recordtype     = "CAA"
+  expected_rdata = ["0 issue \"ca.example.net\""]
+  resolvers      = ["127.0.0.1"]
+}
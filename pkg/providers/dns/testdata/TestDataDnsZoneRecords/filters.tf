@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_dns_zone_records" "test" {
+  zone         = "example.com"
+  record_types = ["A", "TXT"]
+  name_pattern = "*.Example.com"
+  page_size    = 2
+}
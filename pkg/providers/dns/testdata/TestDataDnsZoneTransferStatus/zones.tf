@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_dns_zone_transfer_status" "test" {
+  zones = ["secondary2.example.com", "secondary1.example.com"]
+}
@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_zone_dnssec_keys" "test" {
+  zone = "test.zone.net"
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_bulk_zones" "parked" {
+  contract = "ctr_1-3CV382"
+  type     = "ALIAS"
+  zones    = ["brand.example.net"]
+}
@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_bulk_zones" "parked" {
+  contract   = "ctr_1-3CV382"
+  group      = "grp_18432"
+  type       = "alias"
+  target     = "brand.example.com"
+  zones      = ["brand.example.net", "brand.example.org", "brand-example.com"]
+  batch_size = 2
+}
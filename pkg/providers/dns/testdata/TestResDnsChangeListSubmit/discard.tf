@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_changelist_submit" "test" {
+  zone                = "exampleterraform.io"
+  discard_on_conflict = true
+}
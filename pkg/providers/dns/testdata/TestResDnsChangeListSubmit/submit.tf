@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_changelist_submit" "test" {
+  zone = "exampleterraform.io"
+  triggers = {
+    records = "sha"
+  }
+}
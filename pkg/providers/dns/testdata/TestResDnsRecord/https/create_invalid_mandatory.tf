@@ -0,0 +1,14 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_record" "https_record" {
+  zone          = "exampleterraform.io"
+  name          = "exampleterraform.io"
+  recordtype    = "HTTPS"
+  ttl           = 300
+  svc_priority  = 1
+  target_name   = "."
+  svc_mandatory = ["ech"]
+  svc_alpn      = ["h2"]
+}
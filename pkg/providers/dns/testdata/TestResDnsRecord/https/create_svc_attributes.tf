@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_record" "https_record" {
+  zone          = "exampleterraform.io"
+  name          = "exampleterraform.io"
+  recordtype    = "HTTPS"
+  ttl           = 300
+  svc_priority  = 1
+  target_name   = "."
+  svc_mandatory = ["port"]
+  svc_alpn      = ["h2", "h3"]
+  svc_port      = 8443
+  svc_ipv6hint  = ["2001:0db8::1"]
+}
@@ -0,0 +1,18 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_record" "a_record" {
+  zone                  = "exampleterraform.io"
+  name                  = "exampleterraform.io"
+  recordtype            = "A"
+  active                = true
+  ttl                   = 300
+  target                = ["10.0.0.2"]
+  wait_for_propagation  = true
+  propagation_resolvers = ["%s"]
+
+  timeouts {
+    create = "1s"
+  }
+}
@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_record" "spf_record" {
+  zone                 = "exampleterraform.io"
+  name                 = "exampleterraform.io"
+  recordtype           = "SPF"
+  active               = true
+  ttl                  = 300
+  target               = ["\"v=spf1 -all\""]
+  wait_for_propagation = true
+}
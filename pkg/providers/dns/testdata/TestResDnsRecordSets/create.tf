@@ -0,0 +1,21 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_recordsets" "test" {
+  zone = "exampleterraform.io"
+
+  recordset {
+    name  = "www.exampleterraform.io"
+    type  = "A"
+    ttl   = 300
+    rdata = ["192.0.2.10", "192.0.2.11"]
+  }
+
+  recordset {
+    name  = "exampleterraform.io"
+    type  = "MX"
+    ttl   = 300
+    rdata = ["10 mail.exampleterraform.io."]
+  }
+}
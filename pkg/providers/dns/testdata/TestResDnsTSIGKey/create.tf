@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_tsig_key" "test" {
+  name      = "transfer.example.com"
+  algorithm = "hmac-sha256"
+  secret    = "c2VjcmV0MQ=="
+  zones     = ["secondary1.example.com", "secondary2.example.com"]
+}
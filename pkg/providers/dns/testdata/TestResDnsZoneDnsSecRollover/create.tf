@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_zone_dnssec_rollover" "test" {
+  zone = "test.zone.net"
+  triggers = {
+    year = "2026"
+  }
+}
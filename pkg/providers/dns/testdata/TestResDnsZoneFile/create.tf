@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_zone_file" "test" {
+  zone    = "exampleterraform.io"
+  content = file("testdata/TestResDnsZoneFile/zone.db")
+}
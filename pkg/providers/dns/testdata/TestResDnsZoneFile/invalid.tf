@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_dns_zone_file" "test" {
+  zone    = "exampleterraform.io"
+  content = "www 300 IN AAAA 192.0.2.10\n"
+}
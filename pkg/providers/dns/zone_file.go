@@ -0,0 +1,488 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneFileRecord is a single resource record parsed from an RFC 1035 master file.
+// Owner names are fully qualified, lower case and without the trailing dot, as used by the Edge DNS API.
+type zoneFileRecord struct {
+	line   int
+	name   string
+	ttl    int
+	rtype  string
+	fields []string
+}
+
+// ErrZoneFile is returned when a master file cannot be parsed
+var ErrZoneFile = errors.New("invalid zone file")
+
+var zoneFileRecordTypes = map[string]struct{}{
+	RRTypeA: {}, RRTypeAaaa: {}, RRTypeAfsdb: {}, RRTypeAkamaiCdn: {}, RRTypeAkamaiTlc: {}, RRTypeCaa: {},
+	RRTypeCname: {}, RRTypeHinfo: {}, RRTypeLoc: {}, RRTypeMx: {}, RRTypeNaptr: {}, RRTypeNs: {}, RRTypePtr: {},
+	RRTypeRp: {}, RRTypeSoa: {}, RRTypeSrv: {}, RRTypeSpf: {}, RRTypeSshfp: {}, RRTypeTlsa: {}, RRTypeTxt: {},
+	RRTypeDnskey: {}, RRTypeDs: {}, RRTypeNsec3: {}, RRTypeNsec3Param: {}, RRTypeRrsig: {}, RRTypeCert: {},
+	RRTypeHTTPS: {}, RRTypeSvcb: {},
+}
+
+// zoneFileNameFields lists positions of the rdata fields holding domain names, which are qualified with the origin
+var zoneFileNameFields = map[string][]int{
+	RRTypeAfsdb: {1},
+	RRTypeCname: {0},
+	RRTypeHTTPS: {1},
+	RRTypeMx:    {1},
+	RRTypeNaptr: {5},
+	RRTypeNs:    {0},
+	RRTypePtr:   {0},
+	RRTypeRp:    {0, 1},
+	RRTypeRrsig: {7},
+	RRTypeSoa:   {0, 1},
+	RRTypeSrv:   {3},
+	RRTypeSvcb:  {1},
+}
+
+// zoneFileTrailingFields holds the number of leading rdata fields of types whose last field is base64 or hex data,
+// which master files may split into several space separated chunks
+var zoneFileTrailingFields = map[string]int{
+	RRTypeCert:   3,
+	RRTypeDnskey: 3,
+	RRTypeDs:     3,
+	RRTypeRrsig:  8,
+	RRTypeSshfp:  2,
+	RRTypeTlsa:   3,
+}
+
+// zoneFileEntry is a logical line of a master file, with parentheses and comments removed
+type zoneFileEntry struct {
+	line       int
+	blankOwner bool
+	tokens     []string
+}
+
+// parseZoneFile parses the master file content of the given zone.
+// The $ORIGIN and $TTL directives are supported; $INCLUDE and $GENERATE are not.
+func parseZoneFile(zone, content string) ([]zoneFileRecord, error) {
+	entries, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := strings.ToLower(strings.TrimSuffix(zone, "."))
+	defaultTTL, lastTTL := -1, -1
+	var lastOwner string
+	var records []zoneFileRecord
+	for _, entry := range entries {
+		tokens := entry.tokens
+		if strings.HasPrefix(tokens[0], "$") && !entry.blankOwner {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("%w: line %d: $ORIGIN requires a single domain name", ErrZoneFile, entry.line)
+				}
+				origin = qualifyZoneFileName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("%w: line %d: $TTL requires a single value", ErrZoneFile, entry.line)
+				}
+				if defaultTTL, err = parseZoneFileTTL(tokens[1]); err != nil {
+					return nil, fmt.Errorf("%w: line %d: %s", ErrZoneFile, entry.line, err)
+				}
+			default:
+				return nil, fmt.Errorf("%w: line %d: directive %s is not supported", ErrZoneFile, entry.line, tokens[0])
+			}
+			continue
+		}
+
+		record := zoneFileRecord{line: entry.line, ttl: -1}
+		if entry.blankOwner {
+			if lastOwner == "" {
+				return nil, fmt.Errorf("%w: line %d: record has no owner name", ErrZoneFile, entry.line)
+			}
+			record.name = lastOwner
+		} else {
+			record.name = qualifyZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		lastOwner = record.name
+
+		// TTL and class may be given in any order before the type
+		for len(tokens) > 0 {
+			if class := strings.ToUpper(tokens[0]); class == "IN" || class == "CH" || class == "HS" || class == "CS" {
+				if class != "IN" {
+					return nil, fmt.Errorf("%w: line %d: class %s is not supported", ErrZoneFile, entry.line, class)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl, err := parseZoneFileTTL(tokens[0]); err == nil && record.ttl < 0 {
+				record.ttl = ttl
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("%w: line %d: record type is missing", ErrZoneFile, entry.line)
+		}
+		record.rtype = strings.ToUpper(tokens[0])
+		if _, ok := zoneFileRecordTypes[record.rtype]; !ok {
+			return nil, fmt.Errorf("%w: line %d: record type %s is not supported", ErrZoneFile, entry.line, tokens[0])
+		}
+		if len(tokens) == 1 {
+			return nil, fmt.Errorf("%w: line %d: %s record has no data", ErrZoneFile, entry.line, record.rtype)
+		}
+
+		switch {
+		case record.ttl >= 0:
+		case defaultTTL >= 0:
+			record.ttl = defaultTTL
+		case lastTTL >= 0:
+			record.ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("%w: line %d: record has no TTL and no $TTL is set", ErrZoneFile, entry.line)
+		}
+		lastTTL = record.ttl
+
+		record.fields = normalizeZoneFileFields(record.rtype, tokens[1:], origin)
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// splitZoneFile splits the content into logical entries, joining lines enclosed in parentheses and stripping comments.
+// Quoted strings are kept as single tokens including the quotes.
+func splitZoneFile(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var current zoneFileEntry
+	var token strings.Builder
+	line, depth := 1, 0
+	inQuote, inComment, hasToken, lineStart := false, false, false, true
+
+	flushToken := func() {
+		if hasToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			hasToken = false
+		}
+	}
+	flushEntry := func() {
+		flushToken()
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = zoneFileEntry{}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if lineStart {
+			current.line = line
+			current.blankOwner = c == ' ' || c == '\t'
+			lineStart = false
+		}
+		switch {
+		case c == '\n':
+			if inQuote {
+				return nil, fmt.Errorf("%w: line %d: unterminated quoted string", ErrZoneFile, line)
+			}
+			inComment = false
+			line++
+			if depth == 0 {
+				flushEntry()
+				lineStart = true
+			} else {
+				flushToken()
+			}
+		case inComment:
+		case inQuote:
+			token.WriteByte(c)
+			if c == '\\' && i+1 < len(content) {
+				i++
+				token.WriteByte(content[i])
+			} else if c == '"' {
+				inQuote = false
+			}
+		case c == '"':
+			inQuote, hasToken = true, true
+			token.WriteByte(c)
+		case c == ';':
+			flushToken()
+			inComment = true
+		case c == '(':
+			flushToken()
+			depth++
+		case c == ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("%w: line %d: unbalanced parentheses", ErrZoneFile, line)
+			}
+			depth--
+		case c == ' ' || c == '\t' || c == '\r':
+			flushToken()
+		default:
+			hasToken = true
+			token.WriteByte(c)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("%w: line %d: unterminated quoted string", ErrZoneFile, line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("%w: line %d: unbalanced parentheses", ErrZoneFile, line)
+	}
+	flushEntry()
+
+	return entries, nil
+}
+
+// parseZoneFileTTL parses TTL given in seconds or in the BIND format with units, e.g. 1h30m
+func parseZoneFileTTL(value string) (int, error) {
+	if value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	if ttl, err := strconv.Atoi(value); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, number int
+	var digits bool
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number = number*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		total += number * unit
+		number, digits = 0, false
+	}
+	// trailing number without unit is in seconds
+	return total + number, nil
+}
+
+// qualifyZoneFileName returns the fully qualified, lower case name without the trailing dot
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	case origin == "":
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// normalizeZoneFileFields qualifies domain names in rdata and joins split base64 and hex data,
+// so that records can be compared regardless of how the master file was written
+func normalizeZoneFileFields(rtype string, fields []string, origin string) []string {
+	normalized := append([]string(nil), fields...)
+	if n, ok := zoneFileTrailingFields[rtype]; ok && len(normalized) > n+1 {
+		normalized = append(normalized[:n], strings.Join(normalized[n:], ""))
+	}
+	for _, i := range zoneFileNameFields[rtype] {
+		if i < len(normalized) && normalized[i] != "." {
+			normalized[i] = qualifyZoneFileName(normalized[i], origin) + "."
+		}
+	}
+	switch rtype {
+	case RRTypeA, RRTypeAaaa:
+		if addr, err := netip.ParseAddr(normalized[0]); err == nil {
+			normalized[0] = addr.String()
+		}
+	case RRTypeDs, RRTypeTlsa, RRTypeSshfp:
+		last := len(normalized) - 1
+		normalized[last] = strings.ToUpper(normalized[last])
+	}
+	return normalized
+}
+
+// validate checks the record with the same rules as akamai_dns_record
+func (r zoneFileRecord) validate() error {
+	d, err := r.resourceData()
+	if err != nil {
+		return fmt.Errorf("%w: line %d: %s", ErrZoneFile, r.line, err)
+	}
+	if err := validateRecord(d); err != nil {
+		return fmt.Errorf("%w: line %d: %s record %s: %s", ErrZoneFile, r.line, r.rtype, r.name, err)
+	}
+	return nil
+}
+
+// resourceData maps the record to the attributes of akamai_dns_record
+func (r zoneFileRecord) resourceData() (*schema.ResourceData, error) {
+	attrs := map[string]interface{}{
+		"name":       r.name,
+		"recordtype": r.rtype,
+		"ttl":        r.ttl,
+	}
+	f := r.fields
+	unquote := func(s string) string {
+		return strings.Trim(s, `"`)
+	}
+	var names []string
+	switch r.rtype {
+	case RRTypeAfsdb:
+		names = []string{"subtype", "target"}
+	case RRTypeDnskey:
+		names = []string{"flags", "protocol", "algorithm", "key"}
+	case RRTypeDs:
+		names = []string{"keytag", "algorithm", "digest_type", "digest"}
+	case RRTypeHinfo:
+		names = []string{"hardware", "software"}
+	case RRTypeMx:
+		names = []string{"priority", "target"}
+	case RRTypeNaptr:
+		names = []string{"order", "preference", "flagsnaptr", "service", "regexp", "replacement"}
+	case RRTypeNsec3:
+		names = []string{"algorithm", "flags", "iterations", "salt", "next_hashed_owner_name", "type_bitmaps"}
+		f = joinZoneFileFields(f, len(names))
+	case RRTypeNsec3Param:
+		names = []string{"algorithm", "flags", "iterations", "salt"}
+	case RRTypeRp:
+		names = []string{"mailbox", "txt"}
+	case RRTypeRrsig:
+		names = []string{"type_covered", "algorithm", "labels", "original_ttl", "expiration", "inception", "keytag", "signer", "signature"}
+	case RRTypeSshfp:
+		names = []string{"algorithm", "fingerprint_type", "fingerprint"}
+	case RRTypeSoa:
+		names = []string{"name_server", "email_address", "serial", "refresh", "retry", "expiry", "nxdomain_ttl"}
+	case RRTypeCert:
+		names = []string{"type_value", "keytag", "algorithm", "certificate"}
+		if _, err := strconv.Atoi(f[0]); err != nil {
+			names[0] = "type_mnemonic"
+		}
+	case RRTypeTlsa:
+		names = []string{"usage", "selector", "match_type", "certificate"}
+	case RRTypeSvcb, RRTypeHTTPS:
+		names = []string{"svc_priority", "target_name", "svc_params"}
+		f = joinZoneFileFields(f, len(names))
+	default:
+		attrs["target"] = []interface{}{strings.Join(f, " ")}
+	}
+
+	if names != nil {
+		required := len(names)
+		if r.rtype == RRTypeSvcb || r.rtype == RRTypeHTTPS {
+			// svc_params are not set in the alias mode
+			required--
+		}
+		if len(f) < required || len(f) > len(names) {
+			return nil, fmt.Errorf("%s record expects %d fields (%s), got %d", r.rtype, len(names), strings.Join(names, ", "), len(f))
+		}
+	}
+
+	schemas := getResourceDNSRecordSchema()
+	for i, name := range names {
+		if i >= len(f) {
+			break
+		}
+		if name == "target" {
+			attrs[name] = []interface{}{f[i]}
+			continue
+		}
+		if schemas[name].Type == schema.TypeInt {
+			value, err := strconv.Atoi(f[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s of %s record must be a number, got %q", name, r.rtype, f[i])
+			}
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = unquote(f[i])
+	}
+
+	d := resourceDNSv2Record().Data(nil)
+	for name, value := range attrs {
+		if err := d.Set(name, value); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// joinZoneFileFields joins the fields exceeding count into the last field, separated with spaces
+func joinZoneFileFields(fields []string, count int) []string {
+	if len(fields) <= count {
+		return fields
+	}
+	joined := append([]string(nil), fields[:count-1]...)
+	return append(joined, strings.Join(fields[count-1:], " "))
+}
+
+// key identifies the record in comparisons, ignoring the SOA serial which Edge DNS increments on every change
+func (r zoneFileRecord) key() string {
+	fields := r.fields
+	if r.rtype == RRTypeSoa && len(fields) > 2 {
+		fields = append([]string(nil), fields...)
+		fields[2] = "0"
+	}
+	return fmt.Sprintf("%s %d %s %s", r.name, r.ttl, r.rtype, strings.Join(fields, " "))
+}
+
+// zoneFilesEqual reports whether both master files define the same records of the zone.
+// AKAMAITLC records are read only and only present in exported files, so they are not compared.
+func zoneFilesEqual(zone, old, new string) bool {
+	oldRecords, err := parseZoneFile(zone, old)
+	if err != nil {
+		return false
+	}
+	newRecords, err := parseZoneFile(zone, new)
+	if err != nil {
+		return false
+	}
+	oldKeys, newKeys := zoneFileKeys(oldRecords), zoneFileKeys(newRecords)
+	if len(oldKeys) != len(newKeys) {
+		return false
+	}
+	for i := range oldKeys {
+		if oldKeys[i] != newKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func zoneFileKeys(records []zoneFileRecord) []string {
+	keys := make([]string, 0, len(records))
+	seen := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		if record.rtype == RRTypeAkamaiTlc {
+			continue
+		}
+		key := record.key()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatZoneFile renders the records in the master file format, with fully qualified names and explicit TTLs.
+// AKAMAITLC records are omitted as they cannot be managed.
+func formatZoneFile(records []zoneFileRecord) string {
+	var b strings.Builder
+	for _, record := range records {
+		if record.rtype == RRTypeAkamaiTlc {
+			continue
+		}
+		fmt.Fprintf(&b, "%s.\t%d\tIN\t%s\t%s\n", record.name, record.ttl, record.rtype, strings.Join(record.fields, " "))
+	}
+	return b.String()
+}
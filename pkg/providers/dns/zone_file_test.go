@@ -0,0 +1,148 @@
+package dns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+; apex records
+@       IN  SOA ns1.example.com. hostmaster ( 2024010101 ; serial
+                3600 600 604800 300 )
+        IN  NS  a1-1.akam.net.
+        300 IN  MX  10 mail
+www     IN  A   192.0.2.10
+        IN  AAAA 2001:0db8:0000:0000:0000:0000:0000:0001
+txt 600 IN  TXT "v=spf1 -all" "second; part"
+_sip._tcp   SRV 10 5 5060 sip.example.com.
+@           CAA 0 issue "letsencrypt.org"
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := parseZoneFile("example.com", testZoneFile)
+	require.NoError(t, err)
+
+	var keys []string
+	for _, record := range records {
+		keys = append(keys, record.key())
+		assert.NoError(t, record.validate(), record.key())
+	}
+	assert.Equal(t, []string{
+		"example.com 3600 SOA ns1.example.com. hostmaster.example.com. 0 3600 600 604800 300",
+		"example.com 3600 NS a1-1.akam.net.",
+		"example.com 300 MX 10 mail.example.com.",
+		"www.example.com 3600 A 192.0.2.10",
+		"www.example.com 3600 AAAA 2001:db8::1",
+		`txt.example.com 600 TXT "v=spf1 -all" "second; part"`,
+		"_sip._tcp.example.com 3600 SRV 10 5 5060 sip.example.com.",
+		`example.com 3600 CAA 0 issue "letsencrypt.org"`,
+	}, keys)
+	assert.Equal(t, 4, records[0].line)
+	assert.Equal(t, 6, records[1].line)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := map[string]struct {
+		content   string
+		withError string
+	}{
+		"include directive": {
+			content:   "$INCLUDE other.zone\n",
+			withError: "line 1: directive $INCLUDE is not supported",
+		},
+		"missing ttl": {
+			content:   "www IN A 192.0.2.1\n",
+			withError: "line 1: record has no TTL and no $TTL is set",
+		},
+		"unsupported class": {
+			content:   "www 300 CH A 192.0.2.1\n",
+			withError: "line 1: class CH is not supported",
+		},
+		"unsupported type": {
+			content:   "www 300 IN WKS 192.0.2.1 TCP\n",
+			withError: "line 1: record type WKS is not supported",
+		},
+		"unbalanced parentheses": {
+			content:   "@ 300 SOA ns1 hostmaster ( 1 2 3 4 5\n",
+			withError: "unbalanced parentheses",
+		},
+		"missing owner": {
+			content:   "  300 IN A 192.0.2.1\n",
+			withError: "line 1: record has no owner name",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseZoneFile("example.com", test.content)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrZoneFile))
+			assert.Contains(t, err.Error(), test.withError)
+		})
+	}
+}
+
+func TestParseAndValidateZoneFile(t *testing.T) {
+	tests := map[string]struct {
+		content   string
+		withError string
+	}{
+		"valid records": {
+			content: "mail 300 MX 10 mx.example.net.\n" +
+				"ds 300 DS 60485 5 1 2BB183AF5F22588179A53B0A 98631FAD1A292118\n" +
+				"svc 300 HTTPS 1 . alpn=h2 port=443\n",
+		},
+		"invalid ipv6 address": {
+			content:   "www 300 AAAA 192.0.2.1\n",
+			withError: "line 1: AAAA record www.example.com: target '192.0.2.1' is not a valid IPv6 or IPv4-mapped IPv6 address",
+		},
+		"invalid caa tag": {
+			content:   "@ 300 CAA 0 is-sue \"ca.example.net\"\n",
+			withError: "tag contains invalid characters",
+		},
+		"missing mx fields": {
+			content:   "mail 300 MX mx.example.net.\n",
+			withError: "line 1: MX record expects 2 fields (priority, target), got 1",
+		},
+		"not a number": {
+			content:   "mail 300 MX ten mx.example.net.\n",
+			withError: `priority of MX record must be a number, got "ten"`,
+		},
+		"read only record": {
+			content:   "@ 300 AKAMAITLC 1 192.0.2.1\n",
+			withError: "AKAMAITLC is a READ ONLY record",
+		},
+		"all errors are reported": {
+			content:   "a 300 AAAA 192.0.2.1\nb 300 AAAA 192.0.2.2\n",
+			withError: "line 2: AAAA record b.example.com",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseAndValidateZoneFile("example.com", test.content)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestZoneFilesEqual(t *testing.T) {
+	records, err := parseZoneFile("example.com", testZoneFile)
+	require.NoError(t, err)
+	exported := formatZoneFile(records)
+
+	assert.True(t, zoneFilesEqual("example.com", testZoneFile, exported))
+	assert.True(t, zoneFilesEqual("example.com", testZoneFile,
+		exported+"example.com.\t300\tIN\tAKAMAITLC\t1 192.0.2.1\n"), "AKAMAITLC records are ignored")
+	assert.False(t, zoneFilesEqual("example.com", testZoneFile, exported+"new.example.com.\t300\tIN\tA\t192.0.2.20\n"))
+	assert.False(t, zoneFilesEqual("example.com", testZoneFile, "$INCLUDE other.zone\n"))
+}
@@ -0,0 +1,65 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+)
+
+// zoneTransfers covers the zone transfer operations of the Edge DNS API which are not available in the edgegrid client yet
+type zoneTransfers interface {
+	// GetZoneTransferStatus returns the status of the last zone transfer of each secondary zone
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/get-zones-zone-transfer-status
+	GetZoneTransferStatus(context.Context, []string) ([]zoneTransferStatus, error)
+}
+
+type (
+	zoneTransfersClient struct {
+		session session.Session
+	}
+
+	// zoneTransferStatus is the status of the last transfer of a secondary zone from its masters
+	zoneTransferStatus struct {
+		Zone               string   `json:"zone"`
+		MasterServers      []string `json:"masterServers"`
+		LastTransferDate   string   `json:"lastTransferDate"`
+		LastTransferSerial int64    `json:"lastTransferSerial"`
+		LastTransferResult string   `json:"lastTransferResult"`
+		LastTransferError  string   `json:"lastTransferError"`
+		LastNotifyDate     string   `json:"lastNotifyDate"`
+	}
+
+	zoneTransferStatusResponse struct {
+		Zones []zoneTransferStatus `json:"zones"`
+	}
+)
+
+var (
+	// ErrGetZoneTransferStatus is returned when fetching the zone transfer status fails
+	ErrGetZoneTransferStatus = errors.New("fetching zone transfer status")
+)
+
+func (c *zoneTransfersClient) GetZoneTransferStatus(ctx context.Context, zones []string) ([]zoneTransferStatus, error) {
+	query := url.Values{"zone": zones}
+	uri := "/config-dns/v2/zones/zone-transfer-status?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetZoneTransferStatus, err)
+	}
+
+	var result zoneTransferStatusResponse
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetZoneTransferStatus, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrGetZoneTransferStatus, responseError(resp))
+	}
+
+	return result.Zones, nil
+}
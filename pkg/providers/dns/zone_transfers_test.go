@@ -0,0 +1,74 @@
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockZoneTransfers struct {
+	mock.Mock
+}
+
+func (m *mockZoneTransfers) GetZoneTransferStatus(ctx context.Context, zones []string) ([]zoneTransferStatus, error) {
+	args := m.Called(ctx, zones)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]zoneTransferStatus), args.Error(1)
+}
+
+func TestZoneTransfersClient(t *testing.T) {
+	t.Run("get zone transfer status", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/config-dns/v2/zones/zone-transfer-status", r.URL.Path)
+			assert.Equal(t, []string{"a.example.com", "b.example.com"}, r.URL.Query()["zone"])
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"zones":[{"zone":"a.example.com","masterServers":["192.0.2.1"],
+				"lastTransferDate":"2026-10-19T10:00:00Z","lastTransferSerial":2026101901,"lastTransferResult":"SUCCESS"},
+				{"zone":"b.example.com","masterServers":["192.0.2.2"],"lastTransferResult":"FAILURE","lastTransferError":"TSIG verification failed"}]}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		client := &zoneTransfersClient{session: mockSession(t, mockServer)}
+		statuses, err := client.GetZoneTransferStatus(context.Background(), []string{"a.example.com", "b.example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []zoneTransferStatus{
+			{
+				Zone:               "a.example.com",
+				MasterServers:      []string{"192.0.2.1"},
+				LastTransferDate:   "2026-10-19T10:00:00Z",
+				LastTransferSerial: 2026101901,
+				LastTransferResult: "SUCCESS",
+			},
+			{
+				Zone:               "b.example.com",
+				MasterServers:      []string{"192.0.2.2"},
+				LastTransferResult: "FAILURE",
+				LastTransferError:  "TSIG verification failed",
+			},
+		}, statuses)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"title":"Not Found","status":404,"detail":"zone not found"}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		client := &zoneTransfersClient{session: mockSession(t, mockServer)}
+		_, err := client.GetZoneTransferStatus(context.Background(), []string{"a.example.com"})
+		assert.ErrorIs(t, err, ErrGetZoneTransferStatus)
+		assert.True(t, isNotFound(err))
+	})
+}
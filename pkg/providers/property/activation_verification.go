@@ -0,0 +1,325 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/timeouts"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/logger"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type (
+	// activationVerification is a set of HTTP checks run against the network after the activation completes
+	activationVerification struct {
+		checks            []verificationCheck
+		attempts          int
+		timeout           time.Duration
+		rollbackOnFailure bool
+	}
+
+	// verificationCheck is a single HTTP request with the expected response
+	verificationCheck struct {
+		url             string
+		expectedStatus  int
+		expectedHeaders map[string]string
+		resolveIP       string
+	}
+)
+
+var (
+	// VerificationRetryInterval is the interval between failed attempts of a verification check
+	VerificationRetryInterval = 10 * time.Second
+
+	// ErrVerificationFailed is returned when verification checks do not pass after the activation
+	ErrVerificationFailed = errors.New("activation verification failed")
+)
+
+var verificationSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	MaxItems:    1,
+	Description: "HTTP checks run against the network once the activation is complete",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"check": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "HTTP request to send and the response it is expected to return",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+							Description:      "URL to request",
+						},
+						"expected_status": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          http.StatusOK,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(100, 599)),
+							Description:      "Expected HTTP status code of the response. Redirects are not followed",
+						},
+						"expected_headers": {
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validateHeaderPatterns,
+							Description:      "Response headers with regular expressions their values must match",
+						},
+						"resolve_ip": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+							Description:      "IP address to send the request to instead of resolving the URL host, for example the address of the staging edge hostname",
+						},
+					},
+				},
+			},
+			"attempts": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          3,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "How many times a failing check is attempted before verification fails",
+			},
+			"timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "30s",
+				ValidateDiagFunc: timeouts.ValidateDurationFormat,
+				Description:      "Timeout of a single HTTP request",
+			},
+			"rollback_on_failure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to reactivate the previously active version when verification fails",
+			},
+		},
+	},
+}
+
+func validateHeaderPatterns(v interface{}, _ cty.Path) diag.Diagnostics {
+	for name, pattern := range v.(map[string]interface{}) {
+		if _, err := regexp.Compile(pattern.(string)); err != nil {
+			return diag.Errorf("invalid pattern for header %q: %s", name, err)
+		}
+	}
+	return nil
+}
+
+// getActivationVerification reads the verification block. It returns nil when verification is not configured.
+func getActivationVerification(d *schema.ResourceData) (*activationVerification, error) {
+	list, err := tf.GetListValue("verification", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(list) == 0 || list[0] == nil {
+		return nil, nil
+	}
+
+	block := list[0].(map[string]interface{})
+	timeout, err := time.ParseDuration(block["timeout"].(string))
+	if err != nil {
+		return nil, err
+	}
+	verification := activationVerification{
+		attempts:          block["attempts"].(int),
+		timeout:           timeout,
+		rollbackOnFailure: block["rollback_on_failure"].(bool),
+	}
+
+	for _, c := range block["check"].([]interface{}) {
+		checkMap := c.(map[string]interface{})
+		check := verificationCheck{
+			url:             checkMap["url"].(string),
+			expectedStatus:  checkMap["expected_status"].(int),
+			resolveIP:       checkMap["resolve_ip"].(string),
+			expectedHeaders: make(map[string]string),
+		}
+		for name, pattern := range checkMap["expected_headers"].(map[string]interface{}) {
+			check.expectedHeaders[name] = pattern.(string)
+		}
+		verification.checks = append(verification.checks, check)
+	}
+
+	return &verification, nil
+}
+
+// run executes all checks and returns an error describing each check which did not pass
+func (v *activationVerification) run(ctx context.Context) error {
+	log := logger.Get("PAPI", "activationVerification")
+
+	var failures []string
+	for _, check := range v.checks {
+		client := check.httpClient(v.timeout)
+		var err error
+		for attempt := 1; attempt <= v.attempts; attempt++ {
+			if err = check.run(ctx, client); err == nil {
+				break
+			}
+			log.Debugf("verification of %s failed on attempt %d: %s", check.url, attempt, err)
+			if attempt == v.attempts {
+				break
+			}
+			select {
+			case <-time.After(VerificationRetryInterval):
+			case <-ctx.Done():
+				return fmt.Errorf("%w: %w", ErrVerificationFailed, ctx.Err())
+			}
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", check.url, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%w: %s", ErrVerificationFailed, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// httpClient returns a client which does not follow redirects and, if requested, connects to the resolve IP
+// while keeping the URL host for the Host header and TLS server name
+func (c verificationCheck) httpClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.resolveIP != "" {
+		// a proxy would receive the connection instead of the edge server
+		transport.Proxy = nil
+		dialer := &net.Dialer{Timeout: timeout}
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			_, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(c.resolveIP, port))
+		}
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (c verificationCheck) run(ctx context.Context, client *http.Client) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	var mismatches []string
+	if resp.StatusCode != c.expectedStatus {
+		mismatches = append(mismatches, fmt.Sprintf("expected status %d, got %d", c.expectedStatus, resp.StatusCode))
+	}
+
+	names := make([]string, 0, len(c.expectedHeaders))
+	for name := range c.expectedHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pattern := regexp.MustCompile(c.expectedHeaders[name])
+		values := resp.Header.Values(name)
+		if len(values) == 0 {
+			mismatches = append(mismatches, fmt.Sprintf("missing header %s", name))
+			continue
+		}
+		if !pattern.MatchString(strings.Join(values, ", ")) {
+			mismatches = append(mismatches, fmt.Sprintf("header %s value %q does not match %q", name, strings.Join(values, ", "), pattern))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return errors.New(strings.Join(mismatches, ", "))
+	}
+	return nil
+}
+
+// verifyActivation runs the configured verification checks. A failure is reported as a warning so that
+// the activation is kept in state. When rollback is requested, rollbackVersion is activated on the network
+// and the failure is reported as an error.
+func verifyActivation(ctx context.Context, d *schema.ResourceData, client papi.PAPI, propertyID string,
+	network papi.ActivationNetwork, rollbackVersion int) diag.Diagnostics {
+	verification, err := getActivationVerification(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if verification == nil {
+		return nil
+	}
+
+	verifyErr := verification.run(ctx)
+	if verifyErr == nil {
+		return nil
+	}
+	if !verification.rollbackOnFailure {
+		return verificationWarning(verifyErr)
+	}
+	if rollbackVersion == 0 {
+		// the verified version stays active, so it is kept in state as well
+		return verificationWarning(fmt.Errorf("%w, rollback not possible: %w", verifyErr, errNoPreviousVersion))
+	}
+
+	note := fmt.Sprintf("Rollback of %s on %s to version %d after failed verification", propertyID, network, rollbackVersion)
+	request, err := newActivationRequest(d, propertyID, network, rollbackVersion, note, true)
+	if err != nil {
+		return append(diag.FromErr(verifyErr), diag.FromErr(err)...)
+	}
+
+	activationID, diags := createActivation(ctx, client, request)
+	if diags != nil {
+		return append(diag.FromErr(verifyErr), diags...)
+	}
+	act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+		ActivationID: activationID,
+		PropertyID:   propertyID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%w, rollback to version %d failed: %w", verifyErr, rollbackVersion, err))
+	}
+	if _, diags := pollActivation(ctx, client, act.Activation, propertyID); diags != nil {
+		return append(diag.FromErr(verifyErr), diags...)
+	}
+
+	return diag.FromErr(fmt.Errorf("%w, version %d was reactivated on %s", verifyErr, rollbackVersion, network))
+}
+
+func verificationWarning(err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Activation verification failed",
+		Detail:   err.Error(),
+	}}
+}
@@ -0,0 +1,181 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivationVerification(t *testing.T) {
+	origInterval := VerificationRetryInterval
+	VerificationRetryInterval = time.Millisecond
+	defer func() { VerificationRetryInterval = origInterval }()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		host, _, err := net.SplitHostPort(r.Host)
+		assert.NoError(t, err)
+		assert.Equal(t, "www.example.com", host)
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "/", http.StatusMovedPermanently)
+		case "/broken":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("X-Cache", "TCP_HIT from a23-1-2-3.deploy.akamaitechnologies.com")
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	checkURL := func(path string) string {
+		return "http://www.example.com:" + serverURL.Port() + path
+	}
+
+	tests := map[string]struct {
+		checks           []verificationCheck
+		expectedRequests int
+		withError        string
+	}{
+		"all checks pass": {
+			checks: []verificationCheck{
+				{url: checkURL("/"), expectedStatus: http.StatusOK, expectedHeaders: map[string]string{"X-Cache": "^TCP_HIT"}, resolveIP: "127.0.0.1"},
+				{url: checkURL("/redirect"), expectedStatus: http.StatusMovedPermanently, resolveIP: "127.0.0.1"},
+			},
+			expectedRequests: 2,
+		},
+		"failing checks are retried": {
+			checks: []verificationCheck{
+				{url: checkURL("/broken"), expectedStatus: http.StatusOK, expectedHeaders: map[string]string{"X-Cache": "TCP_HIT"}, resolveIP: "127.0.0.1"},
+			},
+			expectedRequests: 2,
+			withError: "activation verification failed: " + checkURL("/broken") +
+				": expected status 200, got 503, missing header X-Cache",
+		},
+		"header mismatch": {
+			checks: []verificationCheck{
+				{url: checkURL("/"), expectedStatus: http.StatusOK, expectedHeaders: map[string]string{"X-Cache": "TCP_MISS"}, resolveIP: "127.0.0.1"},
+			},
+			expectedRequests: 2,
+			withError:        `header X-Cache value "TCP_HIT from a23-1-2-3.deploy.akamaitechnologies.com" does not match "TCP_MISS"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			verification := activationVerification{checks: test.checks, attempts: 2, timeout: 5 * time.Second}
+			err := verification.run(context.Background())
+			assert.Equal(t, test.expectedRequests, requests)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrVerificationFailed))
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyActivationRollback(t *testing.T) {
+	origInterval := VerificationRetryInterval
+	VerificationRetryInterval = time.Millisecond
+	defer func() { VerificationRetryInterval = origInterval }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"property_id": "prp_test",
+		"version":     3,
+		"contact":     []interface{}{"user@example.com"},
+		"verification": []interface{}{map[string]interface{}{
+			"check":               []interface{}{map[string]interface{}{"url": server.URL}},
+			"attempts":            1,
+			"rollback_on_failure": true,
+		}},
+	}
+	note := "Rollback of prp_test on STAGING to version 2 after failed verification"
+
+	t.Run("previous version is reactivated", func(t *testing.T) {
+		client := &papi.Mock{}
+		expectCreateActivation(client, "prp_test", papi.ActivationTypeActivate, 2, papi.ActivationNetworkStaging,
+			[]string{"user@example.com"}, note, "atv_rollback", false, nil).Once()
+		expectGetActivation(client, "prp_test", "atv_rollback", 2, papi.ActivationNetworkStaging, papi.ActivationStatusActive,
+			papi.ActivationTypeActivate, note, []string{"user@example.com"}, nil).Once()
+
+		d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, config)
+		diags := verifyActivation(context.Background(), d, client, "prp_test", papi.ActivationNetworkStaging, 2)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "expected status 200, got 500")
+		assert.Contains(t, diags[0].Summary, "version 2 was reactivated on STAGING")
+		client.AssertExpectations(t)
+	})
+
+	t.Run("rule warnings are acknowledged when configured", func(t *testing.T) {
+		client := &papi.Mock{}
+		expectCreateActivation(client, "prp_test", papi.ActivationTypeActivate, 2, papi.ActivationNetworkStaging,
+			[]string{"user@example.com"}, note, "atv_rollback", true, nil).Once()
+		expectGetActivation(client, "prp_test", "atv_rollback", 2, papi.ActivationNetworkStaging, papi.ActivationStatusActive,
+			papi.ActivationTypeActivate, note, []string{"user@example.com"}, nil).Once()
+
+		acknowledgeConfig := map[string]interface{}{"auto_acknowledge_rule_warnings": true}
+		for k, v := range config {
+			acknowledgeConfig[k] = v
+		}
+		d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, acknowledgeConfig)
+		diags := verifyActivation(context.Background(), d, client, "prp_test", papi.ActivationNetworkStaging, 2)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "version 2 was reactivated on STAGING")
+		client.AssertExpectations(t)
+	})
+
+	t.Run("no previous version", func(t *testing.T) {
+		client := &papi.Mock{}
+		d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, config)
+		diags := verifyActivation(context.Background(), d, client, "prp_test", papi.ActivationNetworkStaging, 0)
+		require.False(t, diags.HasError())
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, "rollback not possible: no previously active version to roll back to")
+		client.AssertExpectations(t)
+	})
+
+	t.Run("failure without rollback is a warning", func(t *testing.T) {
+		client := &papi.Mock{}
+		noRollbackConfig := map[string]interface{}{
+			"verification": []interface{}{map[string]interface{}{
+				"check":    []interface{}{map[string]interface{}{"url": server.URL}},
+				"attempts": 1,
+			}},
+		}
+		for k, v := range config {
+			if k != "verification" {
+				noRollbackConfig[k] = v
+			}
+		}
+		d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, noRollbackConfig)
+		diags := verifyActivation(context.Background(), d, client, "prp_test", papi.ActivationNetworkStaging, 2)
+		require.False(t, diags.HasError())
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, "expected status 200, got 500")
+		client.AssertExpectations(t)
+	})
+}
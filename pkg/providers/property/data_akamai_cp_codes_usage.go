@@ -0,0 +1,276 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCPCodesUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataCPCodesUsageRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Contract of the CP codes and properties",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Group of the CP codes and properties",
+			},
+			"cp_codes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "CP codes of the contract and group with their usage",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cp_code_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the CP code",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the CP code",
+						},
+						"purgeable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether content can be purged by the CP code",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the CP code",
+						},
+						"reporting_group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the reporting groups the CP code belongs to",
+						},
+						"property_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the properties whose latest, staging or production version references the CP code",
+						},
+						"used": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether any property rule tree references the CP code",
+						},
+					},
+				},
+			},
+			"unused_cp_code_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the CP codes not referenced by any property rule tree",
+			},
+		},
+	}
+}
+
+// cpCodeUsage is a CP code together with the reporting groups and properties it is used by
+type cpCodeUsage struct {
+	cpCode          cprgCPCode
+	reportingGroups []string
+	properties      []string
+}
+
+func dataCPCodesUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := Client(meta)
+	cprg := reportingGroupsClientFor(meta)
+	log := meta.Log("PAPI", "dataCPCodesUsageRead")
+	log.Debug("Reading CP codes usage")
+
+	contractID, err := tf.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = str.AddPrefix(contractID, "ctr_")
+
+	groupID, err := tf.GetStringValue("group_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groupID = str.AddPrefix(groupID, "grp_")
+
+	usage, err := buildCPCodesUsage(ctx, client, cprg, contractID, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cpCodes := make([]interface{}, 0, len(usage))
+	unused := make([]string, 0)
+	for _, u := range usage {
+		id := cpCodePrefix + strconv.Itoa(u.cpCode.CPCodeID)
+		cpCodes = append(cpCodes, map[string]interface{}{
+			"cp_code_id":          id,
+			"name":                u.cpCode.CPCodeName,
+			"purgeable":           u.cpCode.Purgeable,
+			"type":                u.cpCode.Type,
+			"reporting_group_ids": u.reportingGroups,
+			"property_ids":        u.properties,
+			"used":                len(u.properties) > 0,
+		})
+		if len(u.properties) == 0 {
+			unused = append(unused, id)
+		}
+	}
+
+	attrs := map[string]interface{}{
+		"cp_codes":           cpCodes,
+		"unused_cp_code_ids": unused,
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", contractID, groupID))
+	return nil
+}
+
+// buildCPCodesUsage lists CP codes of the contract and group and cross-references them with reporting groups
+// and the rule trees of the latest, staging and production versions of the properties
+func buildCPCodesUsage(ctx context.Context, client papi.PAPI, cprg reportingGroups, contractID, groupID string) ([]cpCodeUsage, error) {
+	groupNumber, err := str.GetIntID(groupID, "grp_")
+	if err != nil {
+		return nil, fmt.Errorf("invalid group id %q: %s", groupID, err)
+	}
+	accessGroup := listReportingGroupsRequest{ContractID: strings.TrimPrefix(contractID, "ctr_"), GroupID: groupNumber}
+
+	cpCodes, err := cprg.ListCPCodes(ctx, accessGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := cprg.ListReportingGroups(ctx, accessGroup)
+	if err != nil {
+		return nil, err
+	}
+	groupsByCPCode := make(map[int][]string)
+	for _, group := range groups {
+		for _, contract := range group.Contracts {
+			for _, cpCode := range contract.CPCodes {
+				groupsByCPCode[cpCode.CPCodeID] = append(groupsByCPCode[cpCode.CPCodeID], strconv.Itoa(group.ReportingGroupID))
+			}
+		}
+	}
+
+	properties, err := client.GetProperties(ctx, papi.GetPropertiesRequest{ContractID: contractID, GroupID: groupID})
+	if err != nil {
+		return nil, fmt.Errorf("could not list properties: %s", err)
+	}
+	propertiesByCPCode := make(map[int][]string)
+	for _, property := range properties.Properties.Items {
+		referenced := make(map[int]struct{})
+		for _, version := range propertyVersionsInUse(property) {
+			rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+				PropertyID:      property.PropertyID,
+				PropertyVersion: version,
+				ContractID:      contractID,
+				GroupID:         groupID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not get rules of version %d of property %s: %s", version, property.PropertyID, err)
+			}
+			for _, cpCodeID := range ruleCPCodes(rules.Rules) {
+				referenced[cpCodeID] = struct{}{}
+			}
+		}
+		for cpCodeID := range referenced {
+			propertiesByCPCode[cpCodeID] = append(propertiesByCPCode[cpCodeID], property.PropertyID)
+		}
+	}
+
+	usage := make([]cpCodeUsage, 0, len(cpCodes))
+	for _, cpCode := range cpCodes {
+		u := cpCodeUsage{
+			cpCode:          cpCode,
+			reportingGroups: groupsByCPCode[cpCode.CPCodeID],
+			properties:      propertiesByCPCode[cpCode.CPCodeID],
+		}
+		sort.Strings(u.reportingGroups)
+		sort.Strings(u.properties)
+		usage = append(usage, u)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].cpCode.CPCodeID < usage[j].cpCode.CPCodeID
+	})
+
+	return usage, nil
+}
+
+// propertyVersionsInUse returns the latest version of the property and the versions active on staging and production
+func propertyVersionsInUse(property *papi.Property) []int {
+	versions := []int{property.LatestVersion}
+	seen := map[int]bool{property.LatestVersion: true}
+	for _, version := range []*int{property.StagingVersion, property.ProductionVersion} {
+		if version != nil && !seen[*version] {
+			seen[*version] = true
+			versions = append(versions, *version)
+		}
+	}
+	return versions
+}
+
+// cpCodeOptions maps behaviors to their options holding a CP code, as defined in the rule format schemas
+var cpCodeOptions = map[string][]string{
+	"apiPrioritization":     {"throttledCpCode"},
+	"cpCode":                {"value"},
+	"failAction":            {"cpCode"},
+	"imageAndVideoManager":  {"cpCodeOriginal", "cpCodeTransformed"},
+	"imageManager":          {"cpCodeOriginal", "cpCodeTransformed"},
+	"imageManagerVideo":     {"cpCodeOriginal", "cpCodeTransformed"},
+	"visitorPrioritization": {"waitingRoomCpCode"},
+}
+
+// ruleCPCodes returns IDs of CP codes referenced by behaviors of the rule and its children
+func ruleCPCodes(rule papi.Rules) []int {
+	var ids []int
+	for _, behavior := range rule.Behaviors {
+		for _, name := range cpCodeOptions[behavior.Name] {
+			if id, ok := cpCodeOptionID(behavior.Options[name]); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	for _, child := range rule.Children {
+		ids = append(ids, ruleCPCodes(child)...)
+	}
+	return ids
+}
+
+func cpCodeOptionID(value interface{}) (int, bool) {
+	option, ok := value.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	switch id := option["id"].(type) {
+	case float64:
+		return int(id), true
+	case int:
+		return id, true
+	case json.Number:
+		i, err := id.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package property
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCPCodesUsage(t *testing.T) {
+	rulesWithCPCodes := func(ids ...int) papi.Rules {
+		rules := papi.Rules{Name: "default"}
+		for _, id := range ids {
+			rules.Children = append(rules.Children, papi.Rules{
+				Name: "child",
+				Behaviors: []papi.RuleBehavior{{
+					Name:    "cpCode",
+					Options: papi.RuleOptionsMap{"value": map[string]interface{}{"id": float64(id)}},
+				}},
+			})
+		}
+		return rules
+	}
+	expectGetRuleTree := func(m *papi.Mock, propertyID string, version int, rules papi.Rules) {
+		m.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			PropertyID:      propertyID,
+			PropertyVersion: version,
+			ContractID:      "ctr_C-1",
+			GroupID:         "grp_12",
+		}).Return(&papi.GetRuleTreeResponse{Rules: rules}, nil).Once()
+	}
+
+	accessGroup := listReportingGroupsRequest{ContractID: "C-1", GroupID: 12}
+	cprg := &mockReportingGroups{}
+	cprg.On("ListCPCodes", mock.Anything, accessGroup).Return([]cprgCPCode{
+		{CPCodeID: 300, CPCodeName: "unused", Purgeable: true},
+		{CPCodeID: 100, CPCodeName: "static"},
+		{CPCodeID: 200, CPCodeName: "images"},
+	}, nil).Once()
+	cprg.On("ListReportingGroups", mock.Anything, accessGroup).Return([]reportingGroup{
+		{ReportingGroupID: 2, Contracts: []reportingGroupContract{{CPCodes: []cprgCPCodeRef{{CPCodeID: 100}, {CPCodeID: 300}}}}},
+		{ReportingGroupID: 1, Contracts: []reportingGroupContract{{CPCodes: []cprgCPCodeRef{{CPCodeID: 100}}}}},
+	}, nil).Once()
+
+	client := &papi.Mock{}
+	client.On("GetProperties", mock.Anything, papi.GetPropertiesRequest{ContractID: "ctr_C-1", GroupID: "grp_12"}).
+		Return(&papi.GetPropertiesResponse{Properties: papi.PropertiesItems{Items: []*papi.Property{
+			{PropertyID: "prp_1", LatestVersion: 3, StagingVersion: ptr.To(3), ProductionVersion: ptr.To(2)},
+			{PropertyID: "prp_2", LatestVersion: 1},
+		}}}, nil).Once()
+	expectGetRuleTree(client, "prp_1", 3, rulesWithCPCodes(100))
+	expectGetRuleTree(client, "prp_1", 2, rulesWithCPCodes(100, 200))
+	expectGetRuleTree(client, "prp_2", 1, papi.Rules{
+		Name: "default",
+		Behaviors: []papi.RuleBehavior{{
+			Name:    "imageManager",
+			Options: papi.RuleOptionsMap{"cpCodeOriginal": map[string]interface{}{"id": float64(200)}, "enabled": true},
+		}},
+	})
+
+	usage, err := buildCPCodesUsage(context.Background(), client, cprg, "ctr_C-1", "grp_12")
+	require.NoError(t, err)
+	assert.Equal(t, []cpCodeUsage{
+		{cpCode: cprgCPCode{CPCodeID: 100, CPCodeName: "static"}, reportingGroups: []string{"1", "2"}, properties: []string{"prp_1"}},
+		{cpCode: cprgCPCode{CPCodeID: 200, CPCodeName: "images"}, properties: []string{"prp_1", "prp_2"}},
+		{cpCode: cprgCPCode{CPCodeID: 300, CPCodeName: "unused", Purgeable: true}, reportingGroups: []string{"2"}},
+	}, usage)
+	client.AssertExpectations(t)
+	cprg.AssertExpectations(t)
+}
+
+func TestRuleCPCodes(t *testing.T) {
+	rules := papi.Rules{
+		Name: "default",
+		Behaviors: []papi.RuleBehavior{
+			{Name: "cpCode", Options: papi.RuleOptionsMap{"value": map[string]interface{}{"id": float64(100)}}},
+			{Name: "failAction", Options: papi.RuleOptionsMap{"cpCode": map[string]interface{}{"id": float64(200)}}},
+			{Name: "origin", Options: papi.RuleOptionsMap{"useCpCode": map[string]interface{}{"id": float64(900)}}},
+		},
+		Children: []papi.Rules{{
+			Name: "waiting room",
+			Behaviors: []papi.RuleBehavior{
+				{Name: "visitorPrioritization", Options: papi.RuleOptionsMap{
+					"waitingRoomCpCode":    map[string]interface{}{"id": float64(300)},
+					"waitingRoomUseCpCode": true,
+				}},
+			},
+		}},
+	}
+
+	assert.Equal(t, []int{100, 200, 300}, ruleCPCodes(rules))
+}
@@ -0,0 +1,350 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePropertyIncludeGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyIncludeGraphRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifies the contract under which the data was requested",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifies the group under which the data was requested",
+			},
+			"include_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the graph to a single include. When not set, the graph covers all includes in the contract and group",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The includes with the properties referencing them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The include's unique identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive name for the include",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies the type of the include, either `MICROSERVICES` or `COMMON_SETTINGS`",
+						},
+						"latest_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Specifies the most recent version of the include",
+						},
+						"staging_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The include version active on the staging network",
+						},
+						"production_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The include version active on the production network",
+						},
+						"parents": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The properties referencing the include",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The property's unique identifier",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A descriptive name for the property",
+									},
+									"staging_version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The property version active on the staging network",
+									},
+									"production_version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The property version active on the production network",
+									},
+									"staging_include_version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The include version served on staging through the property. Empty when the active property version does not reference the include",
+									},
+									"production_include_version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The include version served on production through the property. Empty when the active property version does not reference the include",
+									},
+									"is_include_used_in_staging_version": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Indicates if the property version active on staging references the include",
+									},
+									"is_include_used_in_production_version": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Indicates if the property version active on production references the include",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"staging_affected_property_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of properties whose staging version is affected by activating the includes on staging",
+			},
+			"production_affected_property_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of properties whose production version is affected by activating the includes on production",
+			},
+		},
+	}
+}
+
+type (
+	// includeGraphNode is an include together with the properties referencing it
+	includeGraphNode struct {
+		include papi.Include
+		parents []includeGraphParent
+	}
+
+	// includeGraphParent is a property referencing an include, with the include usage on each network
+	includeGraphParent struct {
+		property         papi.ParentProperty
+		usedInStaging    bool
+		usedInProduction bool
+	}
+)
+
+func dataPropertyIncludeGraphRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := Client(meta)
+	log := meta.Log("PAPI", "dataPropertyIncludeGraphRead")
+	log.Debug("Reading property include graph")
+
+	contractID, err := tf.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID, err := tf.GetStringValue("group_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	includeID, err := tf.GetStringValue("include_id", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if includeID != "" {
+		includeID = str.AddPrefix(includeID, "inc_")
+	}
+
+	graph, err := buildIncludeGraph(ctx, client, contractID, groupID, includeID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stagingAffected, productionAffected := affectedProperties(graph)
+	attrs := map[string]interface{}{
+		"includes":                         flattenIncludeGraph(graph),
+		"staging_affected_property_ids":    stagingAffected,
+		"production_affected_property_ids": productionAffected,
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := []string{contractID, groupID}
+	if includeID != "" {
+		id = append(id, includeID)
+	}
+	d.SetId(strings.Join(id, ":"))
+	return nil
+}
+
+// buildIncludeGraph lists includes of the contract and group together with their parent properties.
+// For every parent, the property versions active on staging and production are checked for references to the include.
+func buildIncludeGraph(ctx context.Context, client papi.PAPI, contractID, groupID, includeID string) ([]includeGraphNode, error) {
+	includes, err := client.ListIncludes(ctx, papi.ListIncludesRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list includes: %s", err)
+	}
+
+	items := includes.Includes.Items
+	if includeID != "" {
+		items = nil
+		for _, include := range includes.Includes.Items {
+			if str.AddPrefix(include.IncludeID, "inc_") == includeID {
+				items = append(items, include)
+			}
+		}
+		if len(items) == 0 {
+			return nil, fmt.Errorf("include %s not found in contract %s and group %s", includeID, contractID, groupID)
+		}
+	}
+
+	// property versions are often shared by several includes, so referenced includes are fetched only once per version.
+	// Parent properties may belong to other groups than the include, so they are queried in their own contract and group.
+	referenced := make(map[string]map[string]bool)
+	isReferenced := func(property papi.ParentProperty, version int, includeID string) (bool, error) {
+		key := fmt.Sprintf("%s:%d", property.PropertyID, version)
+		if _, ok := referenced[key]; !ok {
+			resp, err := client.ListReferencedIncludes(ctx, papi.ListReferencedIncludesRequest{
+				ContractID:      property.ContractID,
+				GroupID:         property.GroupID,
+				PropertyID:      property.PropertyID,
+				PropertyVersion: version,
+			})
+			if err != nil {
+				return false, fmt.Errorf("could not list includes referenced by version %d of property %s: %s", version, property.PropertyID, err)
+			}
+			referenced[key] = make(map[string]bool, len(resp.Includes.Items))
+			for _, include := range resp.Includes.Items {
+				referenced[key][str.AddPrefix(include.IncludeID, "inc_")] = true
+			}
+		}
+		return referenced[key][str.AddPrefix(includeID, "inc_")], nil
+	}
+
+	graph := make([]includeGraphNode, 0, len(items))
+	for _, include := range items {
+		parents, err := client.ListIncludeParents(ctx, papi.ListIncludeParentsRequest{
+			ContractID: contractID,
+			GroupID:    groupID,
+			IncludeID:  include.IncludeID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not list parents of include %s: %s", include.IncludeID, err)
+		}
+
+		node := includeGraphNode{include: include}
+		for _, property := range parents.Properties.Items {
+			parent := includeGraphParent{property: property}
+			if property.StagingVersion != nil {
+				if parent.usedInStaging, err = isReferenced(property, *property.StagingVersion, include.IncludeID); err != nil {
+					return nil, err
+				}
+			}
+			if property.ProductionVersion != nil {
+				if parent.usedInProduction, err = isReferenced(property, *property.ProductionVersion, include.IncludeID); err != nil {
+					return nil, err
+				}
+			}
+			node.parents = append(node.parents, parent)
+		}
+		graph = append(graph, node)
+	}
+
+	return graph, nil
+}
+
+// affectedProperties returns sorted IDs of properties whose active versions reference any include of the graph
+func affectedProperties(graph []includeGraphNode) (staging, production []string) {
+	stagingIDs, productionIDs := make(map[string]struct{}), make(map[string]struct{})
+	for _, node := range graph {
+		for _, parent := range node.parents {
+			if parent.usedInStaging {
+				stagingIDs[parent.property.PropertyID] = struct{}{}
+			}
+			if parent.usedInProduction {
+				productionIDs[parent.property.PropertyID] = struct{}{}
+			}
+		}
+	}
+
+	toSortedList := func(ids map[string]struct{}) []string {
+		list := make([]string, 0, len(ids))
+		for id := range ids {
+			list = append(list, id)
+		}
+		sort.Strings(list)
+		return list
+	}
+	return toSortedList(stagingIDs), toSortedList(productionIDs)
+}
+
+func flattenIncludeGraph(graph []includeGraphNode) []interface{} {
+	versionString := func(version *int) string {
+		if version == nil {
+			return ""
+		}
+		return strconv.Itoa(*version)
+	}
+
+	includes := make([]interface{}, 0, len(graph))
+	for _, node := range graph {
+		parents := make([]interface{}, 0, len(node.parents))
+		for _, parent := range node.parents {
+			var stagingIncludeVersion, productionIncludeVersion string
+			if parent.usedInStaging {
+				stagingIncludeVersion = versionString(node.include.StagingVersion)
+			}
+			if parent.usedInProduction {
+				productionIncludeVersion = versionString(node.include.ProductionVersion)
+			}
+			parents = append(parents, map[string]interface{}{
+				"id":                                    parent.property.PropertyID,
+				"name":                                  parent.property.PropertyName,
+				"staging_version":                       versionString(parent.property.StagingVersion),
+				"production_version":                    versionString(parent.property.ProductionVersion),
+				"staging_include_version":               stagingIncludeVersion,
+				"production_include_version":            productionIncludeVersion,
+				"is_include_used_in_staging_version":    parent.usedInStaging,
+				"is_include_used_in_production_version": parent.usedInProduction,
+			})
+		}
+
+		includes = append(includes, map[string]interface{}{
+			"id":                 node.include.IncludeID,
+			"name":               node.include.IncludeName,
+			"type":               string(node.include.IncludeType),
+			"latest_version":     node.include.LatestVersion,
+			"staging_version":    versionString(node.include.StagingVersion),
+			"production_version": versionString(node.include.ProductionVersion),
+			"parents":            parents,
+		})
+	}
+	return includes
+}
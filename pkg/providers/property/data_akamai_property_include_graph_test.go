@@ -0,0 +1,185 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func expectListIncludesForGraph(m *papi.Mock) *mock.Call {
+	return m.On("ListIncludes", mock.Anything, papi.ListIncludesRequest{ContractID: "ctr_1", GroupID: "grp_1"}).
+		Return(&papi.ListIncludesResponse{Includes: papi.IncludeItems{Items: []papi.Include{
+			{IncludeID: "inc_1", IncludeName: "include_1", IncludeType: papi.IncludeTypeMicroServices, LatestVersion: 4, StagingVersion: ptr.To(4), ProductionVersion: ptr.To(3)},
+			{IncludeID: "inc_2", IncludeName: "include_2", IncludeType: papi.IncludeTypeCommonSettings, LatestVersion: 2, ProductionVersion: ptr.To(1)},
+		}}}, nil)
+}
+
+func expectListIncludeParents(m *papi.Mock, includeID string, parents ...papi.ParentProperty) *mock.Call {
+	return m.On("ListIncludeParents", mock.Anything, papi.ListIncludeParentsRequest{ContractID: "ctr_1", GroupID: "grp_1", IncludeID: includeID}).
+		Return(&papi.ListIncludeParentsResponse{Properties: papi.ParentPropertyItems{Items: parents}}, nil)
+}
+
+func expectListReferencedIncludes(m *papi.Mock, parent papi.ParentProperty, version int, includeIDs ...string) *mock.Call {
+	var items []papi.Include
+	for _, id := range includeIDs {
+		items = append(items, papi.Include{IncludeID: id})
+	}
+	return m.On("ListReferencedIncludes", mock.Anything, papi.ListReferencedIncludesRequest{ContractID: parent.ContractID, GroupID: parent.GroupID, PropertyID: parent.PropertyID, PropertyVersion: version}).
+		Return(&papi.ListReferencedIncludesResponse{Includes: papi.IncludeItems{Items: items}}, nil)
+}
+
+var (
+	graphParent1 = papi.ParentProperty{PropertyID: "prp_1", PropertyName: "property_1", ContractID: "ctr_1", GroupID: "grp_1", StagingVersion: ptr.To(6), ProductionVersion: ptr.To(5)}
+	graphParent2 = papi.ParentProperty{PropertyID: "prp_2", PropertyName: "property_2", ContractID: "ctr_1", GroupID: "grp_2", ProductionVersion: ptr.To(1)}
+)
+
+// mockIncludeGraph mocks two includes: inc_1 referenced by prp_1 on both networks and inc_2 referenced
+// by prp_1 on staging only and by prp_2, which belongs to another group, on production only.
+// Referenced includes are listed once per property version.
+func mockIncludeGraph(m *papi.Mock) {
+	expectListIncludesForGraph(m).Once()
+	expectListIncludeParents(m, "inc_1", graphParent1).Once()
+	expectListIncludeParents(m, "inc_2", graphParent1, graphParent2).Once()
+	expectListReferencedIncludes(m, graphParent1, 6, "inc_1", "inc_2").Once()
+	expectListReferencedIncludes(m, graphParent1, 5, "inc_1").Once()
+	expectListReferencedIncludes(m, graphParent2, 1, "inc_2").Once()
+}
+
+func TestDataPropertyIncludeGraph(t *testing.T) {
+	tests := map[string]struct {
+		givenTF            string
+		init               func(*papi.Mock)
+		expectedAttributes map[string]string
+		expectError        *regexp.Regexp
+	}{
+		"whole graph": {
+			givenTF: "valid.tf",
+			init:    mockIncludeGraph,
+			expectedAttributes: map[string]string{
+				"id":                   "ctr_1:grp_1",
+				"includes.#":           "2",
+				"includes.0.id":        "inc_1",
+				"includes.0.parents.#": "1",
+				"includes.0.parents.0.staging_include_version":    "4",
+				"includes.0.parents.0.production_include_version": "3",
+				"includes.1.id":           "inc_2",
+				"includes.1.parents.#":    "2",
+				"includes.1.parents.0.id": "prp_1",
+				"includes.1.parents.0.is_include_used_in_staging_version":    "true",
+				"includes.1.parents.0.is_include_used_in_production_version": "false",
+				"includes.1.parents.0.staging_include_version":               "",
+				"includes.1.parents.0.production_include_version":            "",
+				"includes.1.parents.1.id":                                    "prp_2",
+				"includes.1.parents.1.staging_version":                       "",
+				"includes.1.parents.1.production_version":                    "1",
+				"includes.1.parents.1.production_include_version":            "1",
+				"staging_affected_property_ids.#":                            "1",
+				"staging_affected_property_ids.0":                            "prp_1",
+				"production_affected_property_ids.#":                         "2",
+				"production_affected_property_ids.0":                         "prp_1",
+				"production_affected_property_ids.1":                         "prp_2",
+			},
+		},
+		"single include": {
+			givenTF: "include_id.tf",
+			init: func(m *papi.Mock) {
+				expectListIncludesForGraph(m).Once()
+				expectListIncludeParents(m, "inc_2", graphParent1, graphParent2).Once()
+				expectListReferencedIncludes(m, graphParent1, 6, "inc_1", "inc_2").Once()
+				expectListReferencedIncludes(m, graphParent1, 5, "inc_1").Once()
+				expectListReferencedIncludes(m, graphParent2, 1, "inc_2").Once()
+			},
+			expectedAttributes: map[string]string{
+				"id":                                 "ctr_1:grp_1:inc_2",
+				"includes.#":                         "1",
+				"includes.0.id":                      "inc_2",
+				"staging_affected_property_ids.#":    "1",
+				"production_affected_property_ids.#": "1",
+				"production_affected_property_ids.0": "prp_2",
+			},
+		},
+		"error listing includes": {
+			givenTF: "valid.tf",
+			init: func(m *papi.Mock) {
+				m.On("ListIncludes", mock.Anything, papi.ListIncludesRequest{ContractID: "ctr_1", GroupID: "grp_1"}).
+					Return(nil, fmt.Errorf("oops")).Once()
+			},
+			expectError: regexp.MustCompile("could not list includes: oops"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			if test.init != nil {
+				test.init(client)
+			}
+			var checkFuncs []resource.TestCheckFunc
+			for k, v := range test.expectedAttributes {
+				checkFuncs = append(checkFuncs, resource.TestCheckResourceAttr("data.akamai_property_include_graph.graph", k, v))
+			}
+			useClient(client, nil, func() {
+				resource.Test(t, resource.TestCase{
+					IsUnitTest:               true,
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, fmt.Sprintf("testdata/TestDataPropertyIncludeGraph/%s", test.givenTF)),
+						Check:       resource.ComposeAggregateTestCheckFunc(checkFuncs...),
+						ExpectError: test.expectError,
+					}},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBuildIncludeGraph(t *testing.T) {
+	client := &papi.Mock{}
+	mockIncludeGraph(client)
+
+	graph, err := buildIncludeGraph(context.Background(), client, "ctr_1", "grp_1", "")
+	require.NoError(t, err)
+	require.Len(t, graph, 2)
+	assert.Equal(t, []includeGraphParent{{property: graphParent1, usedInStaging: true, usedInProduction: true}}, graph[0].parents)
+	assert.Equal(t, []includeGraphParent{
+		{property: graphParent1, usedInStaging: true},
+		{property: graphParent2, usedInProduction: true},
+	}, graph[1].parents)
+
+	staging, production := affectedProperties(graph)
+	assert.Equal(t, []string{"prp_1"}, staging)
+	assert.Equal(t, []string{"prp_1", "prp_2"}, production)
+	client.AssertExpectations(t)
+}
+
+func TestBuildIncludeGraphIncludeWithoutPrefix(t *testing.T) {
+	client := &papi.Mock{}
+	expectListIncludesForGraph(client).Once()
+	expectListIncludeParents(client, "inc_1", graphParent1).Once()
+	expectListReferencedIncludes(client, graphParent1, 6, "1").Once()
+	expectListReferencedIncludes(client, graphParent1, 5).Once()
+
+	graph, err := buildIncludeGraph(context.Background(), client, "ctr_1", "grp_1", "inc_1")
+	require.NoError(t, err)
+	require.Len(t, graph, 1)
+	assert.Equal(t, []includeGraphParent{{property: graphParent1, usedInStaging: true}}, graph[0].parents)
+	client.AssertExpectations(t)
+}
+
+func TestBuildIncludeGraphIncludeNotFound(t *testing.T) {
+	client := &papi.Mock{}
+	expectListIncludesForGraph(client).Once()
+
+	_, err := buildIncludeGraph(context.Background(), client, "ctr_1", "grp_1", "inc_3")
+	assert.EqualError(t, err, "include inc_3 not found in contract ctr_1 and group grp_1")
+	client.AssertExpectations(t)
+}
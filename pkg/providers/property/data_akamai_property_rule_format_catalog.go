@@ -0,0 +1,201 @@
+package property
+
+import (
+	"context"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePropertyRuleFormatCatalog() *schema.Resource {
+	catalogItem := func(kind string) *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the " + kind + " as used in rule tree JSON",
+				},
+				"schema_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the " + kind + " as used in akamai_property_rules_builder",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the " + kind,
+				},
+				"allowed_in_includes": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "States whether the " + kind + " can be used in includes",
+				},
+				"allowed_in_default_rule": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "States whether the " + kind + " can be used in the default rule",
+				},
+				"allowed_in_child_rules": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "States whether the " + kind + " can be used in child rules",
+				},
+				"required_in_default_rule": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "States whether the " + kind + " must be present in the default rule",
+				},
+				"options": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The options of the " + kind,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the option as used in rule tree JSON",
+							},
+							"schema_name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the option as used in akamai_property_rules_builder",
+							},
+							"type": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The type of the option. One of 'string', 'bool', 'int', 'float', 'list', 'object' or 'map'",
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The description of the option",
+							},
+							"allowed_values": {
+								Type:        schema.TypeList,
+								Computed:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "The values accepted by the option. Empty when the option is not an enum",
+							},
+							"pattern": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The regular expression the option value has to match",
+							},
+							"range": {
+								Type:        schema.TypeList,
+								Computed:    true,
+								Description: "The range of values accepted by the integer option",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"min": {
+											Type:        schema.TypeInt,
+											Computed:    true,
+											Description: "The minimal accepted value",
+										},
+										"max": {
+											Type:        schema.TypeInt,
+											Computed:    true,
+											Description: "The maximal accepted value",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataPropertyRuleFormatCatalogRead,
+		Schema: map[string]*schema.Schema{
+			"rule_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.ValidateRuleFormat,
+				Description:      "The rule format to describe, e.g. 'v2024-05-31'",
+			},
+			"behaviors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        catalogItem("behavior"),
+				Description: "The behaviors available in the rule format",
+			},
+			"criteria": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        catalogItem("criterion"),
+				Description: "The criteria available in the rule format",
+			},
+		},
+	}
+}
+
+func dataPropertyRuleFormatCatalogRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "dataPropertyRuleFormatCatalogRead")
+
+	ruleFormat, err := tf.GetStringValue("rule_format", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.Debugf("reading catalog of rule format %s", ruleFormat)
+
+	catalog, err := ruleformats.GetCatalog(ruleFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("behaviors", flattenCatalogItems(catalog.Behaviors)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("criteria", flattenCatalogItems(catalog.Criteria)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ruleFormat)
+	return nil
+}
+
+func flattenCatalogItems(items []ruleformats.CatalogItem) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		options := make([]map[string]interface{}, 0, len(item.Options))
+		for _, option := range item.Options {
+			var valueRange []map[string]interface{}
+			if option.MinValue != nil && option.MaxValue != nil {
+				valueRange = []map[string]interface{}{{
+					"min": *option.MinValue,
+					"max": *option.MaxValue,
+				}}
+			}
+			options = append(options, map[string]interface{}{
+				"name":           option.Name,
+				"schema_name":    option.SchemaName,
+				"type":           option.Type,
+				"description":    option.Description,
+				"allowed_values": option.AllowedValues,
+				"pattern":        option.Pattern,
+				"range":          valueRange,
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"name":                     item.Name,
+			"schema_name":              item.SchemaName,
+			"description":              item.Description,
+			"allowed_in_includes":      item.AllowedInIncludes,
+			"allowed_in_default_rule":  item.Placement.AllowedInDefaultRule,
+			"allowed_in_child_rules":   item.Placement.AllowedInChildRules,
+			"required_in_default_rule": item.Placement.RequiredInDefaultRule,
+			"options":                  options,
+		})
+	}
+
+	return result
+}
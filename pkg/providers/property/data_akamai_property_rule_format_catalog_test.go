@@ -0,0 +1,162 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDSPropertyRuleFormatCatalog(t *testing.T) {
+	tests := map[string]struct {
+		givenTF     string
+		checks      []resource.TestCheckFunc
+		expectError *regexp.Regexp
+	}{
+		"happy path": {
+			givenTF: "valid.tf",
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_property_rule_format_catalog.catalog", "id", "v2024-05-31"),
+				resource.TestCheckTypeSetElemNestedAttrs("data.akamai_property_rule_format_catalog.catalog", "behaviors.*", map[string]string{
+					"name":                "adScalerCircuitBreaker",
+					"schema_name":         "ad_scaler_circuit_breaker",
+					"allowed_in_includes": "false",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.akamai_property_rule_format_catalog.catalog", "behaviors.*", map[string]string{
+					"name":                     "caching",
+					"allowed_in_includes":      "true",
+					"allowed_in_default_rule":  "true",
+					"allowed_in_child_rules":   "true",
+					"required_in_default_rule": "false",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.akamai_property_rule_format_catalog.catalog", "behaviors.*", map[string]string{
+					"name":                     "cpCode",
+					"required_in_default_rule": "true",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.akamai_property_rule_format_catalog.catalog", "criteria.*", map[string]string{
+					"name":                    "path",
+					"schema_name":             "path",
+					"allowed_in_default_rule": "false",
+				}),
+			},
+		},
+		"rule format not in registry": {
+			givenTF:     "not_supported.tf",
+			expectError: regexp.MustCompile("rule format is not supported: v2000-01-01"),
+		},
+		"latest is not accepted": {
+			givenTF:     "invalid.tf",
+			expectError: regexp.MustCompile(`"rule_format" 'latest' is not valid`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, fmt.Sprintf("testdata/TestDSPropertyRuleFormatCatalog/%s", test.givenTF)),
+						Check:       resource.ComposeAggregateTestCheckFunc(test.checks...),
+						ExpectError: test.expectError,
+					}},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetRuleFormatCatalog(t *testing.T) {
+	catalog, err := ruleformats.GetCatalog("v2024-05-31")
+	require.NoError(t, err)
+
+	findItem := func(items []ruleformats.CatalogItem, name string) ruleformats.CatalogItem {
+		for _, item := range items {
+			if item.Name == name {
+				return item
+			}
+		}
+		require.Failf(t, "item not found", "%s", name)
+		return ruleformats.CatalogItem{}
+	}
+	findOption := func(item ruleformats.CatalogItem, name string) ruleformats.CatalogOption {
+		for _, option := range item.Options {
+			if option.Name == name {
+				return option
+			}
+		}
+		require.Failf(t, "option not found", "%s.%s", item.Name, name)
+		return ruleformats.CatalogOption{}
+	}
+
+	caching := findItem(catalog.Behaviors, "caching")
+	assert.True(t, caching.AllowedInIncludes)
+	assert.Equal(t, []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}, findOption(caching, "behavior").AllowedValues)
+	assert.Equal(t, "^[0-9]+[DdHhMmSs]$|{{.+}}", findOption(caching, "ttl").Pattern)
+	assert.Equal(t, "bool", findOption(caching, "honorNoStore").Type)
+	for _, option := range caching.Options {
+		assert.NotContains(t, []string{"locked", "uuid", "templateUuid"}, option.Name)
+	}
+
+	compression := findOption(findItem(catalog.Behaviors, "adaptiveImageCompression"), "tier1MobileCompressionValue")
+	require.NotNil(t, compression.MinValue)
+	require.NotNil(t, compression.MaxValue)
+	assert.Equal(t, 0, *compression.MinValue)
+	assert.Equal(t, 100, *compression.MaxValue)
+
+	assert.Equal(t, "object", findOption(findItem(catalog.Behaviors, "cpCode"), "value").Type)
+	assert.False(t, findItem(catalog.Behaviors, "adScalerCircuitBreaker").AllowedInIncludes)
+
+	assert.Equal(t, ruleformats.Placement{AllowedInDefaultRule: true, AllowedInChildRules: true}, caching.Placement)
+	assert.Equal(t, ruleformats.Placement{AllowedInDefaultRule: true, AllowedInChildRules: true, RequiredInDefaultRule: true}, findItem(catalog.Behaviors, "origin").Placement)
+	assert.Equal(t, ruleformats.Placement{AllowedInDefaultRule: true}, findItem(catalog.Behaviors, "subCustomer").Placement)
+	assert.Equal(t, ruleformats.Placement{AllowedInChildRules: true}, findItem(catalog.Criteria, "path").Placement)
+
+	_, err = ruleformats.GetCatalog("v2000-01-01")
+	assert.True(t, errors.Is(err, ruleformats.ErrRuleFormatNotSupported))
+}
+
+func TestDataPropertyRuleFormatCatalogRead(t *testing.T) {
+	m, err := meta.New(session.Must(session.New()), hclog.NewNullLogger(), "")
+	require.NoError(t, err)
+	d := schema.TestResourceDataRaw(t, dataSourcePropertyRuleFormatCatalog().Schema, map[string]interface{}{
+		"rule_format": "v2024-05-31",
+	})
+
+	diags := dataPropertyRuleFormatCatalogRead(context.Background(), d, m)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "v2024-05-31", d.Id())
+
+	findItem := func(kind, name string) map[string]interface{} {
+		for _, item := range d.Get(kind).([]interface{}) {
+			if item := item.(map[string]interface{}); item["name"] == name {
+				return item
+			}
+		}
+		require.Failf(t, "item not found", "%s.%s", kind, name)
+		return nil
+	}
+
+	caching := findItem("behaviors", "caching")
+	assert.Equal(t, true, caching["allowed_in_default_rule"])
+	assert.Equal(t, true, caching["allowed_in_child_rules"])
+	assert.Equal(t, false, caching["required_in_default_rule"])
+	assert.Equal(t, true, findItem("behaviors", "cpCode")["required_in_default_rule"])
+	path := findItem("criteria", "path")
+	assert.Equal(t, false, path["allowed_in_default_rule"])
+	assert.Equal(t, true, path["allowed_in_child_rules"])
+}
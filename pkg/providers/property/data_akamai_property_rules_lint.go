@@ -0,0 +1,476 @@
+package property
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type (
+	// lintFinding is a single problem found in a rule tree
+	lintFinding struct {
+		Check    string
+		Severity string
+		RulePath string
+		Message  string
+	}
+
+	// lintCheck inspects a single rule. Ancestors are ordered from the default rule to the direct parent
+	lintCheck func(rule papi.Rules, ancestors []papi.Rules) []string
+
+	lintRegistration struct {
+		name     string
+		severity string
+		check    lintCheck
+	}
+)
+
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+	lintSeverityInfo    = "info"
+	lintSeverityOff     = "off"
+
+	lintFailOnNone = "none"
+)
+
+// lintSeverityOrder is used to compare severities; higher is more severe
+var lintSeverityOrder = map[string]int{
+	lintSeverityInfo:    1,
+	lintSeverityWarning: 2,
+	lintSeverityError:   3,
+}
+
+// lintChecks contains all checks run by akamai_property_rules_lint with their default severities
+var lintChecks = []lintRegistration{
+	{name: "duplicate_rule_name", severity: lintSeverityError, check: lintDuplicateRuleName},
+	{name: "missing_cp_code", severity: lintSeverityError, check: lintMissingCPCode},
+	{name: "unreachable_rule", severity: lintSeverityWarning, check: lintUnreachableRule},
+	{name: "origin_tls_verification", severity: lintSeverityWarning, check: lintOriginTLSVerification},
+	{name: "caching_downstream_cache", severity: lintSeverityWarning, check: lintCachingDownstreamCache},
+	{name: "origin_sure_route", severity: lintSeverityInfo, check: lintOriginSureRoute},
+}
+
+func lintCheckNames() []string {
+	names := make([]string, 0, len(lintChecks))
+	for _, c := range lintChecks {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// lintPolicySchema returns the schema of the policy blocks overriding severities of the checks
+func lintPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Overrides the default severity of a check",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"check": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: tf.ValidateStringInSlice(lintCheckNames()),
+					Description:      "The name of the check",
+				},
+				"severity": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: tf.ValidateStringInSlice([]string{lintSeverityError, lintSeverityWarning,
+						lintSeverityInfo, lintSeverityOff}),
+					Description: "The severity of the check findings. Use 'off' to disable the check",
+				},
+			},
+		},
+	}
+}
+
+// lintFailOnSchema returns the schema of the lowest severity of findings which fails the described operation
+func lintFailOnSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  lintFailOnNone,
+		ValidateDiagFunc: tf.ValidateStringInSlice([]string{lintFailOnNone, lintSeverityError, lintSeverityWarning,
+			lintSeverityInfo}),
+		Description: description,
+	}
+}
+
+func dataSourcePropertyRulesLint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyRulesLintRead,
+		Schema: map[string]*schema.Schema{
+			"rules": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.ValidateJSON,
+				Description:      "Rule tree JSON to check",
+			},
+			"policy":  lintPolicySchema(),
+			"fail_on": lintFailOnSchema("The lowest severity of findings which makes the data source fail. 'none' never fails"),
+			"findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The problems found in the rule tree",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"check": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the check which reported the finding",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The severity of the finding",
+						},
+						"rule_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The path to the rule, built from the rule names separated by '/'",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the finding",
+						},
+					},
+				},
+			},
+			"error_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of findings with 'error' severity",
+			},
+			"warning_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of findings with 'warning' severity",
+			},
+		},
+	}
+}
+
+func dataPropertyRulesLintRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "dataPropertyRulesLintRead")
+	logger.Debug("Linting property rules")
+
+	rulesJSON, err := tf.GetStringValue("rules", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	failOn, err := tf.GetStringValue("fail_on", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	severities, err := lintSeverities("policy", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var rules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+		return diag.Errorf("cannot parse rules JSON: %s", err)
+	}
+
+	findings := lintRules(rules.Rules, severities)
+
+	var errorCount, warningCount int
+	result := make([]map[string]interface{}, 0, len(findings))
+	for _, f := range findings {
+		switch f.Severity {
+		case lintSeverityError:
+			errorCount++
+		case lintSeverityWarning:
+			warningCount++
+		}
+		result = append(result, map[string]interface{}{
+			"check":     f.Check,
+			"severity":  f.Severity,
+			"rule_path": f.RulePath,
+			"message":   f.Message,
+		})
+	}
+
+	if diags := lintDiagnostics(findings, failOn); diags.HasError() {
+		return diags
+	}
+
+	if err := tf.SetAttrs(d, map[string]interface{}{
+		"findings":      result,
+		"error_count":   errorCount,
+		"warning_count": warningCount,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	h := sha1.New()
+	h.Write([]byte(rulesJSON))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	return nil
+}
+
+// lintSeverities returns the severities overridden by the policy blocks stored under the given key
+func lintSeverities(key string, d *schema.ResourceData) (map[string]string, error) {
+	policies, err := tf.GetSetValue(key, d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return nil, err
+	}
+
+	severities := make(map[string]string)
+	for _, p := range policies.List() {
+		policy := p.(map[string]interface{})
+		severities[policy["check"].(string)] = policy["severity"].(string)
+	}
+	return severities, nil
+}
+
+// lintDiagnostics returns an error for every finding at least as severe as failOn
+func lintDiagnostics(findings []lintFinding, failOn string) diag.Diagnostics {
+	if failOn == lintFailOnNone {
+		return nil
+	}
+	var diags diag.Diagnostics
+	for _, f := range findings {
+		if lintSeverityOrder[f.Severity] >= lintSeverityOrder[failOn] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s: %s", f.Check, f.RulePath),
+				Detail:   f.Message,
+			})
+		}
+	}
+	return diags
+}
+
+// lintRules runs all enabled checks on every rule of the tree. Severities map overrides default check severities
+func lintRules(rules papi.Rules, severities map[string]string) []lintFinding {
+	var findings []lintFinding
+	for _, c := range lintChecks {
+		severity := c.severity
+		if s, ok := severities[c.name]; ok {
+			severity = s
+		}
+		if severity == lintSeverityOff {
+			continue
+		}
+		walkRules(rules, rules.Name, nil, func(rule papi.Rules, path string, ancestors []papi.Rules) {
+			for _, message := range c.check(rule, ancestors) {
+				findings = append(findings, lintFinding{
+					Check:    c.name,
+					Severity: severity,
+					RulePath: path,
+					Message:  message,
+				})
+			}
+		})
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return lintSeverityOrder[findings[i].Severity] > lintSeverityOrder[findings[j].Severity]
+	})
+	return findings
+}
+
+func walkRules(rule papi.Rules, path string, ancestors []papi.Rules, visit func(papi.Rules, string, []papi.Rules)) {
+	visit(rule, path, ancestors)
+	ancestors = append(ancestors[:len(ancestors):len(ancestors)], rule)
+	for _, child := range rule.Children {
+		walkRules(child, path+"/"+child.Name, ancestors, visit)
+	}
+}
+
+func findBehavior(behaviors []papi.RuleBehavior, name string) *papi.RuleBehavior {
+	for i := range behaviors {
+		if behaviors[i].Name == name {
+			return &behaviors[i]
+		}
+	}
+	return nil
+}
+
+func findBehaviorInTree(rule papi.Rules, name string) bool {
+	if findBehavior(rule.Behaviors, name) != nil {
+		return true
+	}
+	for _, child := range rule.Children {
+		if findBehaviorInTree(child, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func lintDuplicateRuleName(rule papi.Rules, _ []papi.Rules) []string {
+	var messages []string
+	seen := make(map[string]int)
+	for _, child := range rule.Children {
+		seen[child.Name]++
+		if seen[child.Name] == 2 {
+			messages = append(messages, fmt.Sprintf("child rule name '%s' is used more than once", child.Name))
+		}
+	}
+	return messages
+}
+
+// lintMissingCPCode reports a default rule without a CP code and child rules which serve distinct traffic,
+// either from their own origin or for their own hostnames, without a dedicated CP code
+func lintMissingCPCode(rule papi.Rules, ancestors []papi.Rules) []string {
+	if len(ancestors) == 0 {
+		if findBehavior(rule.Behaviors, "cpCode") == nil {
+			return []string{"the default rule does not define the 'cpCode' behavior"}
+		}
+		return nil
+	}
+	if findBehavior(rule.Behaviors, "cpCode") != nil {
+		return nil
+	}
+	// a CP code of the default rule is shared by all traffic, so only child rules are considered
+	for _, ancestor := range ancestors[1:] {
+		if findBehavior(ancestor.Behaviors, "cpCode") != nil {
+			return nil
+		}
+	}
+	if findBehavior(rule.Behaviors, "origin") != nil {
+		return []string{"the rule changes the 'origin' without setting a dedicated 'cpCode' for its traffic"}
+	}
+	if findBehavior(rule.Criteria, "hostname") != nil {
+		return []string{"the rule matches on 'hostname' without setting a dedicated 'cpCode' for its traffic"}
+	}
+	return nil
+}
+
+func lintOriginTLSVerification(rule papi.Rules, _ []papi.Rules) []string {
+	origin := findBehavior(rule.Behaviors, "origin")
+	if origin == nil || origin.Options["originType"] != "CUSTOMER" {
+		return nil
+	}
+	if mode, ok := origin.Options["verificationMode"].(string); !ok || mode == "" {
+		return []string{"the 'origin' behavior does not set 'verificationMode' so the origin TLS certificate is not verified"}
+	}
+	return nil
+}
+
+func lintCachingDownstreamCache(rule papi.Rules, ancestors []papi.Rules) []string {
+	if findBehavior(rule.Behaviors, "caching") == nil || findBehavior(rule.Behaviors, "downstreamCache") != nil {
+		return nil
+	}
+	for _, ancestor := range ancestors {
+		if findBehavior(ancestor.Behaviors, "downstreamCache") != nil {
+			return nil
+		}
+	}
+	return []string{"the 'caching' behavior is used without 'downstreamCache' in the rule or any of its parents"}
+}
+
+func lintOriginSureRoute(rule papi.Rules, ancestors []papi.Rules) []string {
+	if len(ancestors) != 0 {
+		return nil
+	}
+	if !findBehaviorInTree(rule, "origin") || findBehaviorInTree(rule, "sureRoute") {
+		return nil
+	}
+	return []string{"the rule tree defines an 'origin' but does not use the 'sureRoute' behavior"}
+}
+
+// lintUnreachableRule reports rules with criteria that can never be satisfied together
+func lintUnreachableRule(rule papi.Rules, _ []papi.Rules) []string {
+	if rule.CriteriaMustSatisfy != papi.RuleCriteriaMustSatisfyAll || len(rule.Criteria) < 2 {
+		return nil
+	}
+
+	var messages []string
+	for i := 0; i < len(rule.Criteria); i++ {
+		for j := i + 1; j < len(rule.Criteria); j++ {
+			if criteriaContradict(rule.Criteria[i], rule.Criteria[j]) {
+				messages = append(messages, fmt.Sprintf("the '%s' criteria cannot be satisfied at the same time "+
+					"because 'criteria_must_satisfy' is 'all'; the rule and its children are unreachable", rule.Criteria[i].Name))
+			}
+		}
+	}
+	return messages
+}
+
+// criteriaContradict detects two criteria of the same kind that match disjoint value sets
+func criteriaContradict(a, b papi.RuleBehavior) bool {
+	if a.Name != b.Name {
+		return false
+	}
+	for k := range a.Options {
+		if k == "matchOperator" || k == "values" {
+			continue
+		}
+		if !reflect.DeepEqual(a.Options[k], b.Options[k]) {
+			return false
+		}
+	}
+
+	opA, _ := a.Options["matchOperator"].(string)
+	opB, _ := b.Options["matchOperator"].(string)
+	// hostnames never differ by case, other criteria only when they opt out of case sensitive matching
+	foldCase := a.Name == "hostname" || a.Options["matchCaseSensitive"] == false
+	valuesA, okA := criteriaValues(a.Options["values"], foldCase)
+	valuesB, okB := criteriaValues(b.Options["values"], foldCase)
+	if !okA || !okB {
+		return false
+	}
+
+	switch {
+	case opA == "IS_ONE_OF" && opB == "IS_ONE_OF":
+		for v := range valuesA {
+			if _, ok := valuesB[v]; ok {
+				return false
+			}
+		}
+		return true
+	case opA == "IS_ONE_OF" && opB == "IS_NOT_ONE_OF":
+		return isSubset(valuesA, valuesB)
+	case opA == "IS_NOT_ONE_OF" && opB == "IS_ONE_OF":
+		return isSubset(valuesB, valuesA)
+	}
+	return false
+}
+
+func criteriaValues(v interface{}, foldCase bool) (map[string]struct{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+	values := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok || strings.Contains(s, "*") || strings.Contains(s, "{{") {
+			// wildcards and variables cannot be compared statically
+			return nil, false
+		}
+		if foldCase {
+			s = strings.ToLower(s)
+		}
+		values[s] = struct{}{}
+	}
+	return values, true
+}
+
+func isSubset(subset, set map[string]struct{}) bool {
+	for v := range subset {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
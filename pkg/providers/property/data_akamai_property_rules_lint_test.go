@@ -0,0 +1,213 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDSRulesLint(t *testing.T) {
+	tests := map[string]struct {
+		givenTF            string
+		expectedAttributes map[string]string
+		expectError        *regexp.Regexp
+	}{
+		"default policy": {
+			givenTF: "default.tf",
+			expectedAttributes: map[string]string{
+				"findings.#":           "5",
+				"error_count":          "2",
+				"warning_count":        "2",
+				"findings.0.check":     "duplicate_rule_name",
+				"findings.0.severity":  "error",
+				"findings.0.rule_path": "default",
+				"findings.0.message":   "child rule name 'Static' is used more than once",
+				"findings.1.check":     "missing_cp_code",
+				"findings.2.check":     "unreachable_rule",
+				"findings.2.rule_path": "default/Static",
+				"findings.3.check":     "origin_tls_verification",
+				"findings.4.check":     "origin_sure_route",
+				"findings.4.severity":  "info",
+			},
+		},
+		"custom policy": {
+			givenTF: "policy.tf",
+			expectedAttributes: map[string]string{
+				"findings.#":          "3",
+				"error_count":         "0",
+				"warning_count":       "3",
+				"findings.0.check":    "duplicate_rule_name",
+				"findings.0.severity": "warning",
+				"findings.1.check":    "unreachable_rule",
+				"findings.2.check":    "origin_tls_verification",
+			},
+		},
+		"clean rules": {
+			givenTF: "clean.tf",
+			expectedAttributes: map[string]string{
+				"findings.#":    "0",
+				"error_count":   "0",
+				"warning_count": "0",
+			},
+		},
+		"fail on error": {
+			givenTF:     "fail_on_error.tf",
+			expectError: regexp.MustCompile(`duplicate_rule_name: default`),
+		},
+		"invalid check name": {
+			givenTF:     "invalid_check.tf",
+			expectError: regexp.MustCompile(`expected check to be one of`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			var checkFuncs []resource.TestCheckFunc
+			for k, v := range test.expectedAttributes {
+				checkFuncs = append(checkFuncs, resource.TestCheckResourceAttr("data.akamai_property_rules_lint.lint", k, v))
+			}
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, fmt.Sprintf("testdata/TestDSRulesLint/%s", test.givenTF)),
+						Check:       resource.ComposeAggregateTestCheckFunc(checkFuncs...),
+						ExpectError: test.expectError,
+					}},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLintRules(t *testing.T) {
+	tests := map[string]struct {
+		rules    string
+		expected []lintFinding
+	}{
+		"caching without downstream cache": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","behaviors":[{"name":"caching","options":{}}]}]}`,
+			expected: []lintFinding{{
+				Check:    "caching_downstream_cache",
+				Severity: lintSeverityWarning,
+				RulePath: "default/a",
+				Message:  "the 'caching' behavior is used without 'downstreamCache' in the rule or any of its parents",
+			}},
+		},
+		"downstream cache in parent": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}},{"name":"downstreamCache","options":{}}],"children":[{"name":"a","behaviors":[{"name":"caching","options":{}}]}]}`,
+		},
+		"child origin without cp code": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}},{"name":"sureRoute","options":{}}],"children":[{"name":"a","behaviors":[{"name":"origin","options":{"originType":"NET_STORAGE"}}]}]}`,
+			expected: []lintFinding{{
+				Check:    "missing_cp_code",
+				Severity: lintSeverityError,
+				RulePath: "default/a",
+				Message:  "the rule changes the 'origin' without setting a dedicated 'cpCode' for its traffic",
+			}},
+		},
+		"criteria excluding each other": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","behaviors":[{"name":"cpCode","options":{}}],"criteriaMustSatisfy":"all","criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["a.com"]}},{"name":"hostname","options":{"matchOperator":"IS_NOT_ONE_OF","values":["a.com","b.com"]}}]}]}`,
+			expected: []lintFinding{{
+				Check:    "unreachable_rule",
+				Severity: lintSeverityWarning,
+				RulePath: "default/a",
+				Message:  "the 'hostname' criteria cannot be satisfied at the same time because 'criteria_must_satisfy' is 'all'; the rule and its children are unreachable",
+			}},
+		},
+		"hostnames are compared case insensitively": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","behaviors":[{"name":"cpCode","options":{}}],"criteriaMustSatisfy":"all","criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["A.com"]}},{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["a.COM"]}}]}]}`,
+		},
+		"case insensitive criteria are compared case insensitively": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","criteriaMustSatisfy":"all","criteria":[{"name":"fileExtension","options":{"matchOperator":"IS_ONE_OF","matchCaseSensitive":false,"values":["JPG"]}},{"name":"fileExtension","options":{"matchOperator":"IS_NOT_ONE_OF","matchCaseSensitive":false,"values":["jpg"]}}]}]}`,
+			expected: []lintFinding{{
+				Check:    "unreachable_rule",
+				Severity: lintSeverityWarning,
+				RulePath: "default/a",
+				Message:  "the 'fileExtension' criteria cannot be satisfied at the same time because 'criteria_must_satisfy' is 'all'; the rule and its children are unreachable",
+			}},
+		},
+		"case sensitive criteria are compared case sensitively": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","criteriaMustSatisfy":"all","criteria":[{"name":"fileExtension","options":{"matchOperator":"IS_ONE_OF","matchCaseSensitive":true,"values":["JPG"]}},{"name":"fileExtension","options":{"matchOperator":"IS_NOT_ONE_OF","matchCaseSensitive":true,"values":["jpg"]}}]}]}`,
+		},
+		"child hostname without cp code": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["a.com"]}}]}]}`,
+			expected: []lintFinding{{
+				Check:    "missing_cp_code",
+				Severity: lintSeverityError,
+				RulePath: "default/a",
+				Message:  "the rule matches on 'hostname' without setting a dedicated 'cpCode' for its traffic",
+			}},
+		},
+		"child origin with cp code in parent": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}},{"name":"sureRoute","options":{}}],"children":[{"name":"a","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"b","behaviors":[{"name":"origin","options":{"originType":"NET_STORAGE"}}]}]}]}`,
+		},
+		"criteria with any are reachable": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","behaviors":[{"name":"cpCode","options":{}}],"criteriaMustSatisfy":"any","criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["a.com"]}},{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["b.com"]}}]}]}`,
+		},
+		"wildcards are not compared": {
+			rules: `{"name":"default","behaviors":[{"name":"cpCode","options":{}}],"children":[{"name":"a","behaviors":[{"name":"cpCode","options":{}}],"criteriaMustSatisfy":"all","criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["*.a.com"]}},{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["b.a.com"]}}]}]}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rules papi.Rules
+			require.NoError(t, json.Unmarshal([]byte(test.rules), &rules))
+			assert.Equal(t, test.expected, lintRules(rules, nil))
+		})
+	}
+}
+
+func TestCheckRulesLint(t *testing.T) {
+	rules := &papi.GetRuleTreeResponse{Rules: papi.Rules{
+		Name:     "default",
+		Children: []papi.Rules{{Name: "a"}, {Name: "a"}},
+	}}
+
+	tests := map[string]struct {
+		attrs         map[string]interface{}
+		expectedError string
+	}{
+		"linting is skipped by default": {
+			attrs: map[string]interface{}{},
+		},
+		"findings stop the activation": {
+			attrs:         map[string]interface{}{"lint_fail_on": "error"},
+			expectedError: "duplicate_rule_name: default",
+		},
+		"policy lowers severity below the threshold": {
+			attrs: map[string]interface{}{
+				"lint_fail_on": "error",
+				"lint_policy": []interface{}{
+					map[string]interface{}{"check": "duplicate_rule_name", "severity": "warning"},
+					map[string]interface{}{"check": "missing_cp_code", "severity": "off"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourcePropertyActivation().Schema, test.attrs)
+			diags := checkRulesLint(rules, d, log.Log)
+			if test.expectedError == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, test.expectedError, diags[0].Summary)
+		})
+	}
+}
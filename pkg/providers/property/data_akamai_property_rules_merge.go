@@ -0,0 +1,293 @@
+package property
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// mergeStrategyAppend keeps behaviors and criteria from all documents, appending later ones
+	mergeStrategyAppend = "append"
+	// mergeStrategyReplace replaces behaviors and criteria with the same name by those from later documents
+	mergeStrategyReplace = "replace"
+	// mergeStrategyError fails when later documents define a conflicting value for the same rule path
+	mergeStrategyError = "error"
+)
+
+var (
+	// ErrRulesMergeConflict is returned when the documents conflict and the 'error' strategy is used
+	ErrRulesMergeConflict = errors.New("rules merge conflict")
+	// ErrRulesMergeInvalidDocument is returned when one of the documents cannot be parsed as a rule tree
+	ErrRulesMergeInvalidDocument = errors.New("invalid rules document")
+)
+
+func dataSourcePropertyRulesMerge() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyRulesMergeRead,
+		Schema: map[string]*schema.Schema{
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: tf.ValidateJSON,
+				},
+				Description: "Ordered list of rule tree JSON documents to merge. Later documents are merged onto earlier ones",
+			},
+			"strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          mergeStrategyReplace,
+				ValidateDiagFunc: tf.ValidateStringInSlice([]string{mergeStrategyAppend, mergeStrategyReplace, mergeStrategyError}),
+				Description: "How to merge behaviors, criteria and variables with the same name in the same rule. " +
+					"Possible values are 'append', 'replace' and 'error'",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Merged and normalized rule tree JSON",
+			},
+		},
+	}
+}
+
+func dataPropertyRulesMergeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "dataPropertyRulesMergeRead")
+	logger.Debug("Merging property rules")
+
+	documents, err := tf.GetListValue("rules", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	strategy, err := tf.GetStringValue("strategy", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	merged, err := mergeRulesDocuments(tf.InterfaceSliceToStringSlice(documents), strategy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rulesJSON, err := unifyRulesDiff(*merged)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("json", rulesJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	h := sha1.New()
+	h.Write([]byte(rulesJSON))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	return nil
+}
+
+// mergeRulesDocuments parses given rule tree JSON documents and merges them in order
+func mergeRulesDocuments(documents []string, strategy string) (*papi.RulesUpdate, error) {
+	var merged *papi.RulesUpdate
+	for i, document := range documents {
+		var rules papi.RulesUpdate
+		if err := json.Unmarshal([]byte(document), &rules); err != nil {
+			return nil, fmt.Errorf("%w: document %d: %s", ErrRulesMergeInvalidDocument, i, err)
+		}
+		if rules.Rules.Name == "" {
+			return nil, fmt.Errorf("%w: document %d does not contain a named 'rules' object", ErrRulesMergeInvalidDocument, i)
+		}
+		if merged == nil {
+			merged = &rules
+			continue
+		}
+		if err := mergeRules(&merged.Rules, rules.Rules, merged.Rules.Name, strategy); err != nil {
+			return nil, err
+		}
+		if rules.Comments != "" {
+			merged.Comments = rules.Comments
+		}
+	}
+
+	return merged, nil
+}
+
+// mergeRules merges overlay onto base. Children are matched by name and merged recursively,
+// children which are not present in base are appended.
+func mergeRules(base *papi.Rules, overlay papi.Rules, path, strategy string) error {
+	if err := mergeRuleScalars(base, overlay, path, strategy); err != nil {
+		return err
+	}
+
+	behaviors, err := mergeRuleBehaviors(base.Behaviors, overlay.Behaviors, path, "behavior", strategy)
+	if err != nil {
+		return err
+	}
+	base.Behaviors = behaviors
+
+	criteria, err := mergeRuleBehaviors(base.Criteria, overlay.Criteria, path, "criterion", strategy)
+	if err != nil {
+		return err
+	}
+	base.Criteria = criteria
+
+	variables, err := mergeRuleVariables(base.Variables, overlay.Variables, path, strategy)
+	if err != nil {
+		return err
+	}
+	base.Variables = variables
+
+	for _, child := range overlay.Children {
+		idx := findRuleChild(base.Children, child.Name)
+		if idx < 0 {
+			base.Children = append(base.Children, child)
+			continue
+		}
+		if err := mergeRules(&base.Children[idx], child, path+"/"+child.Name, strategy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeRuleScalars overrides the rule's single-valued fields with non-empty values from overlay
+func mergeRuleScalars(base *papi.Rules, overlay papi.Rules, path, strategy string) error {
+	scalars := []struct {
+		name     string
+		base     any
+		overlay  any
+		isEmpty  bool
+		override func()
+	}{
+		{"comments", base.Comments, overlay.Comments, overlay.Comments == "", func() { base.Comments = overlay.Comments }},
+		{"advancedOverride", base.AdvancedOverride, overlay.AdvancedOverride, overlay.AdvancedOverride == "", func() { base.AdvancedOverride = overlay.AdvancedOverride }},
+		{"customOverride", base.CustomOverride, overlay.CustomOverride, overlay.CustomOverride == nil, func() { base.CustomOverride = overlay.CustomOverride }},
+		{"criteriaMustSatisfy", base.CriteriaMustSatisfy, overlay.CriteriaMustSatisfy, overlay.CriteriaMustSatisfy == "", func() { base.CriteriaMustSatisfy = overlay.CriteriaMustSatisfy }},
+		{"criteriaLocked", base.CriteriaLocked, overlay.CriteriaLocked, !overlay.CriteriaLocked, func() { base.CriteriaLocked = overlay.CriteriaLocked }},
+		{"options", base.Options, overlay.Options, overlay.Options == papi.RuleOptions{}, func() { base.Options = overlay.Options }},
+	}
+
+	for _, s := range scalars {
+		if s.isEmpty {
+			continue
+		}
+		if strategy == mergeStrategyError && !reflect.ValueOf(s.base).IsZero() && !reflect.DeepEqual(s.base, s.overlay) {
+			return fmt.Errorf("%w: conflicting '%s' in rule '%s'", ErrRulesMergeConflict, s.name, path)
+		}
+		s.override()
+	}
+
+	return nil
+}
+
+// mergeRuleBehaviors merges behaviors or criteria of a single rule according to the strategy.
+// All occurrences of a name are treated as one group, so that behaviors which can be repeated
+// (e.g. header modifications) are replaced as a whole.
+func mergeRuleBehaviors(base, overlay []papi.RuleBehavior, path, kind, strategy string) ([]papi.RuleBehavior, error) {
+	if strategy == mergeStrategyAppend {
+		return append(base, overlay...), nil
+	}
+
+	var names []string
+	groups := make(map[string][]papi.RuleBehavior)
+	for _, b := range overlay {
+		if _, ok := groups[b.Name]; !ok {
+			names = append(names, b.Name)
+		}
+		groups[b.Name] = append(groups[b.Name], b)
+	}
+
+	for _, name := range names {
+		group := groups[name]
+		var existing []papi.RuleBehavior
+		for _, b := range base {
+			if b.Name == name {
+				existing = append(existing, b)
+			}
+		}
+		if len(existing) == 0 {
+			base = append(base, group...)
+			continue
+		}
+		if strategy == mergeStrategyError {
+			if !behaviorsEqual(existing, group) {
+				return nil, fmt.Errorf("%w: %s '%s' is defined differently in rule '%s'", ErrRulesMergeConflict, kind, name, path)
+			}
+			continue
+		}
+
+		replaced := make([]papi.RuleBehavior, 0, len(base)-len(existing)+len(group))
+		for _, b := range base {
+			if b.Name != name {
+				replaced = append(replaced, b)
+				continue
+			}
+			if group != nil {
+				replaced = append(replaced, group...)
+				group = nil
+			}
+		}
+		base = replaced
+	}
+
+	return base, nil
+}
+
+// mergeRuleVariables merges variables by name. Variable names must be unique, so 'append' behaves like 'replace'
+func mergeRuleVariables(base, overlay []papi.RuleVariable, path, strategy string) ([]papi.RuleVariable, error) {
+	for _, v := range overlay {
+		idx := -1
+		for i := range base {
+			if base[i].Name == v.Name {
+				idx = i
+				break
+			}
+		}
+		switch {
+		case idx < 0:
+			base = append(base, v)
+		case strategy == mergeStrategyError && !reflect.DeepEqual(base[idx], v):
+			return nil, fmt.Errorf("%w: variable '%s' is defined differently in rule '%s'", ErrRulesMergeConflict, v.Name, path)
+		default:
+			base[idx] = v
+		}
+	}
+
+	return base, nil
+}
+
+func behaviorsEqual(a, b []papi.RuleBehavior) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		removeNils(a[i].Options)
+		removeNils(b[i].Options)
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func findRuleChild(children []papi.Rules, name string) int {
+	for i := range children {
+		if children[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
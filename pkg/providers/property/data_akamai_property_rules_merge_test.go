@@ -0,0 +1,143 @@
+package property
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDSRulesMerge(t *testing.T) {
+	tests := map[string]struct {
+		configPath  string
+		expectError *regexp.Regexp
+	}{
+		"merge with replace strategy": {
+			configPath: "testdata/TestDSRulesMerge/replace.tf",
+		},
+		"conflict with error strategy": {
+			configPath:  "testdata/TestDSRulesMerge/error.tf",
+			expectError: regexp.MustCompile(`rules merge conflict: behavior 'caching' is defined differently in rule 'default'`),
+		},
+		"invalid strategy": {
+			configPath:  "testdata/TestDSRulesMerge/invalid_strategy.tf",
+			expectError: regexp.MustCompile(`expected strategy to be one of \['append', 'replace', 'error'\], got overwrite`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			var check resource.TestCheckFunc
+			if test.expectError == nil {
+				check = resource.TestCheckResourceAttr("data.akamai_property_rules_merge.test", "json",
+					compactJSON(testutils.LoadFixtureBytes(t, "testdata/TestDSRulesMerge/merged.json")))
+			}
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, test.configPath),
+						Check:       check,
+						ExpectError: test.expectError,
+					}},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMergeRulesDocuments(t *testing.T) {
+	base := `{"rules":{"name":"default","behaviors":[{"name":"origin","options":{"hostname":"a"}},{"name":"modifyOutgoingResponseHeader","options":{"name":"x"}},{"name":"modifyOutgoingResponseHeader","options":{"name":"y"}}],"variables":[{"name":"PMUSER_A","value":"1","description":"","hidden":false,"sensitive":false}]}}`
+	overlay := `{"rules":{"name":"default","behaviors":[{"name":"modifyOutgoingResponseHeader","options":{"name":"z"}}],"variables":[{"name":"PMUSER_A","value":"2","description":"","hidden":false,"sensitive":false}],"children":[{"name":"child"}]}}`
+
+	tests := map[string]struct {
+		documents []string
+		strategy  string
+		expected  func(t *testing.T, rules papi.Rules)
+		withError error
+	}{
+		"replace replaces all occurrences of a behavior name": {
+			documents: []string{base, overlay},
+			strategy:  mergeStrategyReplace,
+			expected: func(t *testing.T, rules papi.Rules) {
+				require.Len(t, rules.Behaviors, 2)
+				assert.Equal(t, "origin", rules.Behaviors[0].Name)
+				assert.Equal(t, "z", rules.Behaviors[1].Options["name"])
+				assert.Equal(t, "2", *rules.Variables[0].Value)
+				require.Len(t, rules.Children, 1)
+				assert.Equal(t, "child", rules.Children[0].Name)
+			},
+		},
+		"append keeps all behaviors": {
+			documents: []string{base, overlay},
+			strategy:  mergeStrategyAppend,
+			expected: func(t *testing.T, rules papi.Rules) {
+				require.Len(t, rules.Behaviors, 4)
+				assert.Equal(t, "z", rules.Behaviors[3].Options["name"])
+			},
+		},
+		"error on conflicting behaviors": {
+			documents: []string{base, overlay},
+			strategy:  mergeStrategyError,
+			withError: ErrRulesMergeConflict,
+		},
+		"error strategy accepts identical documents": {
+			documents: []string{base, base},
+			strategy:  mergeStrategyError,
+			expected: func(t *testing.T, rules papi.Rules) {
+				assert.Len(t, rules.Behaviors, 3)
+				assert.Len(t, rules.Variables, 1)
+			},
+		},
+		"nested children are merged by path": {
+			documents: []string{
+				`{"rules":{"name":"default","children":[{"name":"a","children":[{"name":"b","comments":"first"}]}]}}`,
+				`{"rules":{"name":"default","children":[{"name":"a","children":[{"name":"b","comments":"second"},{"name":"c"}]}]}}`,
+			},
+			strategy: mergeStrategyReplace,
+			expected: func(t *testing.T, rules papi.Rules) {
+				require.Len(t, rules.Children, 1)
+				require.Len(t, rules.Children[0].Children, 2)
+				assert.Equal(t, "second", rules.Children[0].Children[0].Comments)
+				assert.Equal(t, "c", rules.Children[0].Children[1].Name)
+			},
+		},
+		"conflicting nested comments with error strategy": {
+			documents: []string{
+				`{"rules":{"name":"default","children":[{"name":"a","comments":"first"}]}}`,
+				`{"rules":{"name":"default","children":[{"name":"a","comments":"second"}]}}`,
+			},
+			strategy:  mergeStrategyError,
+			withError: ErrRulesMergeConflict,
+		},
+		"document without rules": {
+			documents: []string{base, `{"comments":"abc"}`},
+			strategy:  mergeStrategyReplace,
+			withError: ErrRulesMergeInvalidDocument,
+		},
+		"invalid document": {
+			documents: []string{`[]`},
+			strategy:  mergeStrategyReplace,
+			withError: ErrRulesMergeInvalidDocument,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := mergeRulesDocuments(test.documents, test.strategy)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			test.expected(t, res.Rules)
+		})
+	}
+}
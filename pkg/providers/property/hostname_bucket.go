@@ -0,0 +1,271 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+)
+
+// hostnameBucket covers the PAPI hostname bucket operations which are not available in the edgegrid PAPI client yet.
+// Hostnames in a bucket are managed per network and are independent of property versions.
+type hostnameBucket interface {
+	// ListPropertyHostnames lists all hostnames of the property bucket active on the given network
+	//
+	// See: https://techdocs.akamai.com/property-mgr/reference/get-property-hostnames
+	ListPropertyHostnames(context.Context, listPropertyHostnamesRequest) ([]bucketHostname, error)
+
+	// PatchPropertyHostnames adds and removes hostnames from the property bucket
+	//
+	// See: https://techdocs.akamai.com/property-mgr/reference/patch-property-hostnames
+	PatchPropertyHostnames(context.Context, patchPropertyHostnamesRequest) (*patchPropertyHostnamesResponse, error)
+
+	// GetPropertyHostnameActivation fetches the status of a hostname bucket activation
+	//
+	// See: https://techdocs.akamai.com/property-mgr/reference/get-property-hostname-activation
+	GetPropertyHostnameActivation(context.Context, getPropertyHostnameActivationRequest) (*hostnameActivation, error)
+}
+
+type (
+	hostnameBucketClient struct {
+		session session.Session
+	}
+
+	listPropertyHostnamesRequest struct {
+		PropertyID        string
+		ContractID        string
+		GroupID           string
+		Network           papi.ActivationNetwork
+		IncludeCertStatus bool
+	}
+
+	listPropertyHostnamesResponse struct {
+		Hostnames struct {
+			Items      []bucketHostname `json:"items"`
+			TotalItems int              `json:"totalItems"`
+		} `json:"hostnames"`
+	}
+
+	bucketHostname struct {
+		CnameFrom                string                 `json:"cnameFrom"`
+		CnameType                papi.HostnameCnameType `json:"cnameType"`
+		StagingCertType          string                 `json:"stagingCertType,omitempty"`
+		StagingCnameTo           string                 `json:"stagingCnameTo,omitempty"`
+		StagingEdgeHostnameID    string                 `json:"stagingEdgeHostnameId,omitempty"`
+		ProductionCertType       string                 `json:"productionCertType,omitempty"`
+		ProductionCnameTo        string                 `json:"productionCnameTo,omitempty"`
+		ProductionEdgeHostnameID string                 `json:"productionEdgeHostnameId,omitempty"`
+		CertStatus               papi.CertStatusItem    `json:"certStatus,omitempty"`
+	}
+
+	patchPropertyHostnamesRequest struct {
+		PropertyID   string
+		ContractID   string
+		GroupID      string
+		Network      papi.ActivationNetwork
+		Note         string
+		NotifyEmails []string
+		Add          []papi.Hostname
+		Remove       []string
+	}
+
+	patchPropertyHostnamesResponse struct {
+		ActivationLink string `json:"activationLink"`
+		ActivationID   string `json:"-"`
+	}
+
+	getPropertyHostnameActivationRequest struct {
+		PropertyID   string
+		ActivationID string
+	}
+
+	hostnameActivation struct {
+		HostnameActivationID string                 `json:"hostnameActivationId"`
+		Network              papi.ActivationNetwork `json:"network"`
+		Status               string                 `json:"status"`
+		Note                 string                 `json:"note"`
+	}
+
+	bucketHostnameAdd struct {
+		CnameType            papi.HostnameCnameType `json:"cnameType"`
+		CnameFrom            string                 `json:"cnameFrom"`
+		CnameTo              string                 `json:"cnameTo,omitempty"`
+		EdgeHostnameID       string                 `json:"edgeHostnameId,omitempty"`
+		CertProvisioningType string                 `json:"certProvisioningType"`
+	}
+
+	patchPropertyHostnamesBody struct {
+		Network      papi.ActivationNetwork `json:"network"`
+		Note         string                 `json:"note,omitempty"`
+		NotifyEmails []string               `json:"notifyEmails,omitempty"`
+		Add          []bucketHostnameAdd    `json:"add"`
+		Remove       []string               `json:"remove"`
+	}
+)
+
+const (
+	// hostnameBucketPageSize is the maximum number of hostnames returned in a single list request
+	hostnameBucketPageSize = 999
+
+	hostnameActivationStatusActive    = "ACTIVE"
+	hostnameActivationStatusFailed    = "FAILED"
+	hostnameActivationStatusAborted   = "ABORTED"
+	hostnameActivationStatusCancelled = "CANCELLED"
+)
+
+var (
+	// ErrListPropertyHostnames is returned when listing bucket hostnames fails
+	ErrListPropertyHostnames = errors.New("listing property hostnames")
+	// ErrPatchPropertyHostnames is returned when updating bucket hostnames fails
+	ErrPatchPropertyHostnames = errors.New("patching property hostnames")
+	// ErrGetPropertyHostnameActivation is returned when fetching a hostname activation fails
+	ErrGetPropertyHostnameActivation = errors.New("fetching property hostname activation")
+)
+
+func (c *hostnameBucketClient) ListPropertyHostnames(ctx context.Context, params listPropertyHostnamesRequest) ([]bucketHostname, error) {
+	var hostnames []bucketHostname
+	for offset := 0; ; offset += hostnameBucketPageSize {
+		uri, err := url.Parse(fmt.Sprintf("/papi/v1/properties/%s/hostnames", params.PropertyID))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListPropertyHostnames, err)
+		}
+		query := uri.Query()
+		addContractAndGroup(query, params.ContractID, params.GroupID)
+		query.Add("network", string(params.Network))
+		query.Add("includeCertStatus", strconv.FormatBool(params.IncludeCertStatus))
+		query.Add("offset", strconv.Itoa(offset))
+		query.Add("limit", strconv.Itoa(hostnameBucketPageSize))
+		uri.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to create request: %s", ErrListPropertyHostnames, err)
+		}
+
+		var result listPropertyHostnamesResponse
+		resp, err := c.session.Exec(req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("%w: request failed: %s", ErrListPropertyHostnames, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%w: %w", ErrListPropertyHostnames, responseError(resp))
+		}
+
+		hostnames = append(hostnames, result.Hostnames.Items...)
+		if len(result.Hostnames.Items) == 0 || len(hostnames) >= result.Hostnames.TotalItems {
+			return hostnames, nil
+		}
+	}
+}
+
+func (c *hostnameBucketClient) PatchPropertyHostnames(ctx context.Context, params patchPropertyHostnamesRequest) (*patchPropertyHostnamesResponse, error) {
+	uri, err := url.Parse(fmt.Sprintf("/papi/v1/properties/%s/hostnames", params.PropertyID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrPatchPropertyHostnames, err)
+	}
+	query := uri.Query()
+	addContractAndGroup(query, params.ContractID, params.GroupID)
+	uri.RawQuery = query.Encode()
+
+	body := patchPropertyHostnamesBody{
+		Network:      params.Network,
+		Note:         params.Note,
+		NotifyEmails: params.NotifyEmails,
+		Add:          make([]bucketHostnameAdd, 0, len(params.Add)),
+		Remove:       params.Remove,
+	}
+	if body.Remove == nil {
+		body.Remove = []string{}
+	}
+	for _, hostname := range params.Add {
+		body.Add = append(body.Add, bucketHostnameAdd{
+			CnameType:            hostname.CnameType,
+			CnameFrom:            hostname.CnameFrom,
+			CnameTo:              hostname.CnameTo,
+			EdgeHostnameID:       hostname.EdgeHostnameID,
+			CertProvisioningType: hostname.CertProvisioningType,
+		})
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPatchPropertyHostnames, err)
+	}
+
+	var result patchPropertyHostnamesResponse
+	resp, err := c.session.Exec(req, &result, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPatchPropertyHostnames, err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%w: %w", ErrPatchPropertyHostnames, responseError(resp))
+	}
+
+	link, err := url.Parse(result.ActivationLink)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid activation link %q: %s", ErrPatchPropertyHostnames, result.ActivationLink, err)
+	}
+	result.ActivationID = path.Base(link.Path)
+
+	return &result, nil
+}
+
+func (c *hostnameBucketClient) GetPropertyHostnameActivation(ctx context.Context, params getPropertyHostnameActivationRequest) (*hostnameActivation, error) {
+	uri := fmt.Sprintf("/papi/v1/properties/%s/hostname-activations/%s", params.PropertyID, params.ActivationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetPropertyHostnameActivation, err)
+	}
+
+	var result struct {
+		HostnameActivations struct {
+			Items []hostnameActivation `json:"items"`
+		} `json:"hostnameActivations"`
+	}
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetPropertyHostnameActivation, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrGetPropertyHostnameActivation, responseError(resp))
+	}
+	if len(result.HostnameActivations.Items) == 0 {
+		return nil, fmt.Errorf("%w: activation %s not found", ErrGetPropertyHostnameActivation, params.ActivationID)
+	}
+
+	return &result.HostnameActivations.Items[0], nil
+}
+
+// addContractAndGroup adds the optional contract and group to the query, the API finds them from the property otherwise
+func addContractAndGroup(query url.Values, contractID, groupID string) {
+	if contractID != "" {
+		query.Add("contractId", contractID)
+	}
+	if groupID != "" {
+		query.Add("groupId", groupID)
+	}
+}
+
+// toHostname returns the hostname as configured on the given network
+func (h bucketHostname) toHostname(network papi.ActivationNetwork) papi.Hostname {
+	hostname := papi.Hostname{
+		CnameType:            h.CnameType,
+		CnameFrom:            h.CnameFrom,
+		CnameTo:              h.StagingCnameTo,
+		EdgeHostnameID:       h.StagingEdgeHostnameID,
+		CertProvisioningType: h.StagingCertType,
+		CertStatus:           h.CertStatus,
+	}
+	if network == papi.ActivationNetworkProduction {
+		hostname.CnameTo = h.ProductionCnameTo
+		hostname.EdgeHostnameID = h.ProductionEdgeHostnameID
+		hostname.CertProvisioningType = h.ProductionCertType
+	}
+	return hostname
+}
@@ -0,0 +1,176 @@
+package property
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHostnameBucket struct {
+	mock.Mock
+}
+
+func (m *mockHostnameBucket) ListPropertyHostnames(ctx context.Context, req listPropertyHostnamesRequest) ([]bucketHostname, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]bucketHostname), args.Error(1)
+}
+
+func (m *mockHostnameBucket) PatchPropertyHostnames(ctx context.Context, req patchPropertyHostnamesRequest) (*patchPropertyHostnamesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*patchPropertyHostnamesResponse), args.Error(1)
+}
+
+func (m *mockHostnameBucket) GetPropertyHostnameActivation(ctx context.Context, req getPropertyHostnameActivationRequest) (*hostnameActivation, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*hostnameActivation), args.Error(1)
+}
+
+func useHostnameBucket(bucketCli hostnameBucket, f func()) {
+	orig := bucketClient
+	bucketClient = bucketCli
+
+	defer func() {
+		bucketClient = orig
+	}()
+
+	f()
+}
+
+func mockHostnameBucketClient(t *testing.T, mockServer *httptest.Server) hostnameBucket {
+	return &hostnameBucketClient{session: mockSession(t, mockServer)}
+}
+
+// mockSession returns a session sending requests to the given test server
+func mockSession(t *testing.T, mockServer *httptest.Server) session.Session {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return s
+}
+
+func TestHostnameBucketClient(t *testing.T) {
+	t.Run("list hostnames follows pages", func(t *testing.T) {
+		var offsets []string
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/papi/v1/properties/prp_1/hostnames", r.URL.Path)
+			assert.Equal(t, "STAGING", r.URL.Query().Get("network"))
+			assert.Equal(t, "true", r.URL.Query().Get("includeCertStatus"))
+			assert.False(t, r.URL.Query().Has("contractId"))
+			assert.False(t, r.URL.Query().Has("groupId"))
+			offsets = append(offsets, r.URL.Query().Get("offset"))
+			item := `{"cnameFrom":"a.example.com","cnameType":"EDGE_HOSTNAME","stagingCnameTo":"a.edgekey.net","stagingCertType":"DEFAULT"}`
+			if r.URL.Query().Get("offset") != "0" {
+				item = `{"cnameFrom":"b.example.com","cnameType":"EDGE_HOSTNAME","stagingCnameTo":"b.edgekey.net","stagingCertType":"CPS_MANAGED"}`
+			}
+			_, err := w.Write([]byte(`{"hostnames":{"totalItems":2,"items":[` + item + `]}}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		hostnames, err := mockHostnameBucketClient(t, mockServer).ListPropertyHostnames(context.Background(), listPropertyHostnamesRequest{
+			PropertyID:        "prp_1",
+			Network:           papi.ActivationNetworkStaging,
+			IncludeCertStatus: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "999"}, offsets)
+		require.Len(t, hostnames, 2)
+		assert.Equal(t, papi.Hostname{
+			CnameType:            papi.HostnameCnameTypeEdgeHostname,
+			CnameFrom:            "b.example.com",
+			CnameTo:              "b.edgekey.net",
+			CertProvisioningType: "CPS_MANAGED",
+		}, hostnames[1].toHostname(papi.ActivationNetworkStaging))
+	})
+
+	t.Run("patch hostnames returns activation id", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, map[string]interface{}{
+				"network": "PRODUCTION",
+				"add": []interface{}{map[string]interface{}{
+					"cnameType":            "EDGE_HOSTNAME",
+					"cnameFrom":            "a.example.com",
+					"cnameTo":              "a.edgekey.net",
+					"certProvisioningType": "DEFAULT",
+				}},
+				"remove": []interface{}{"b.example.com"},
+			}, got)
+			w.WriteHeader(http.StatusAccepted)
+			_, err = w.Write([]byte(`{"activationLink":"/papi/v1/properties/prp_1/hostname-activations/atv_1?contractId=ctr_1"}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		resp, err := mockHostnameBucketClient(t, mockServer).PatchPropertyHostnames(context.Background(), patchPropertyHostnamesRequest{
+			PropertyID: "prp_1",
+			Network:    papi.ActivationNetworkProduction,
+			Add: []papi.Hostname{{
+				CnameType:            papi.HostnameCnameTypeEdgeHostname,
+				CnameFrom:            "a.example.com",
+				CnameTo:              "a.edgekey.net",
+				CertProvisioningType: "DEFAULT",
+			}},
+			Remove: []string{"b.example.com"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "atv_1", resp.ActivationID)
+	})
+
+	t.Run("api error is decoded", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"type":"not_found","title":"Not Found","detail":"activation not found"}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		_, err := mockHostnameBucketClient(t, mockServer).GetPropertyHostnameActivation(context.Background(), getPropertyHostnameActivationRequest{
+			PropertyID:   "prp_1",
+			ActivationID: "atv_1",
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrGetPropertyHostnameActivation))
+		var apiErr *papi.Error
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "activation not found", apiErr.Detail)
+	})
+}
@@ -1,8 +1,13 @@
 package property
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -101,6 +106,9 @@ var (
 	// ErrPropertyInclude is returned when operation on property include fails
 	ErrPropertyInclude = errors.New("property include")
 
+	// ErrParentActivation is returned when activation of the include's parent properties fails
+	ErrParentActivation = errors.New("parent property activation")
+
 	// DiagErrActivationTimeout returned on activation poll timeout
 	DiagErrActivationTimeout = diag.Diagnostic{
 		Severity: diag.Error,
@@ -129,3 +137,19 @@ See: https://www.terraform.io/docs/configuration/resources.html#operation-timeou
 `,
 	}
 )
+
+// responseError decodes the problem details returned by the API
+func responseError(resp *http.Response) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading error response body: %s", err)
+	}
+
+	var apiErr papi.Error
+	if err := json.Unmarshal(data, &apiErr); err != nil {
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, string(data))
+	}
+	apiErr.StatusCode = resp.StatusCode
+
+	return &apiErr
+}
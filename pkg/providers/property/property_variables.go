@@ -0,0 +1,206 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// propertyVariableNameRegexp matches names of property user variables, the prefix is required by PAPI
+var propertyVariableNameRegexp = regexp.MustCompile(`^PMUSER_[A-Z0-9_]+$`)
+
+var propertyVariablesSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Optional:     true,
+	RequiredWith: []string{"rules"},
+	Description:  "Property user variables merged into the default rule of 'rules'",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validatePropertyVariableName,
+				Description:      "Name of the variable, starting with `PMUSER_` and followed by uppercase letters, digits or underscores",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Initial value of the variable. Kept sensitive in plans and state",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the variable",
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the variable is excluded from debug headers",
+			},
+			"sensitive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the variable value is hidden in the Property Manager UI and API responses. Requires `hidden`",
+			},
+		},
+	},
+}
+
+func validatePropertyVariableName(v interface{}, _ cty.Path) diag.Diagnostics {
+	name, ok := v.(string)
+	if !ok {
+		return diag.Errorf("%s: expected string, got %T", tf.ErrInvalidType, v)
+	}
+	if !propertyVariableNameRegexp.MatchString(name) {
+		return diag.Errorf("invalid variable name %q: it must start with 'PMUSER_' followed by uppercase letters, digits or underscores", name)
+	}
+	return nil
+}
+
+// getPropertyVariables returns the variables declared in the 'variable' blocks
+func getPropertyVariables(d tf.ResourceDataFetcher) ([]papi.RuleVariable, error) {
+	v, ok := d.GetOk("variable")
+	if !ok {
+		return nil, nil
+	}
+
+	var variables []papi.RuleVariable
+	names := make(map[string]struct{})
+	for _, item := range v.([]interface{}) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		variable := papi.RuleVariable{
+			Name:      m["name"].(string),
+			Value:     ptr.To(m["value"].(string)),
+			Hidden:    m["hidden"].(bool),
+			Sensitive: m["sensitive"].(bool),
+		}
+		if description := m["description"].(string); description != "" {
+			variable.Description = ptr.To(description)
+		}
+		if _, ok := names[variable.Name]; ok {
+			return nil, fmt.Errorf("variable %s is declared more than once", variable.Name)
+		}
+		if variable.Sensitive && !variable.Hidden {
+			return nil, fmt.Errorf("sensitive variable %s must also be hidden", variable.Name)
+		}
+		names[variable.Name] = struct{}{}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+// validatePropertyVariables implements a schema.CustomizeDiffFunc for akamai_property resource.
+//
+// It fails the plan when variable blocks are not valid or declare variables which are also present in 'rules'.
+func validatePropertyVariables(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("variable") || !d.NewValueKnown("rules") {
+		return nil
+	}
+	variables, err := getPropertyVariables(d)
+	if err != nil || len(variables) == 0 {
+		return err
+	}
+
+	rulesJSON, ok := d.GetOk("rules")
+	if !ok {
+		return nil
+	}
+	var rules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(rulesJSON.(string)), &rules); err != nil {
+		// invalid JSON is reported by the rules validation
+		return nil
+	}
+	for _, variable := range variables {
+		for _, declared := range rules.Rules.Variables {
+			if declared.Name == variable.Name {
+				return fmt.Errorf("variable %s is declared both in 'rules' and in a 'variable' block", variable.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// mergePropertyVariables adds the variables to the default rule, replacing variables with the same name
+func mergePropertyVariables(rules *papi.RulesUpdate, variables []papi.RuleVariable) {
+	for _, variable := range variables {
+		replaced := false
+		for i := range rules.Rules.Variables {
+			if rules.Rules.Variables[i].Name == variable.Name {
+				rules.Rules.Variables[i] = variable
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			rules.Rules.Variables = append(rules.Rules.Variables, variable)
+		}
+	}
+}
+
+// extractPropertyVariables removes the configured variables from the default rule and returns them in the state format,
+// in the order of the configuration. Sensitive values are not returned by PAPI, so the configured value is kept.
+func extractPropertyVariables(rules *papi.RulesUpdate, configured []papi.RuleVariable) []interface{} {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	fetched := make(map[string]papi.RuleVariable)
+	remaining := make([]papi.RuleVariable, 0, len(rules.Rules.Variables))
+	configuredNames := make(map[string]struct{}, len(configured))
+	for _, variable := range configured {
+		configuredNames[variable.Name] = struct{}{}
+	}
+	for _, variable := range rules.Rules.Variables {
+		if _, ok := configuredNames[variable.Name]; ok {
+			fetched[variable.Name] = variable
+			continue
+		}
+		remaining = append(remaining, variable)
+	}
+	rules.Rules.Variables = remaining
+	if len(rules.Rules.Variables) == 0 {
+		rules.Rules.Variables = nil
+	}
+
+	result := make([]interface{}, 0, len(configured))
+	for _, want := range configured {
+		variable, ok := fetched[want.Name]
+		if !ok {
+			// removed outside of terraform, dropping it from the state shows the difference in the plan
+			continue
+		}
+		value := derefString(variable.Value)
+		if variable.Sensitive && value == "" {
+			value = derefString(want.Value)
+		}
+		result = append(result, map[string]interface{}{
+			"name":        variable.Name,
+			"value":       value,
+			"description": derefString(variable.Description),
+			"hidden":      variable.Hidden,
+			"sensitive":   variable.Sensitive,
+		})
+	}
+	return result
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,108 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePropertyVariableName(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		withError bool
+	}{
+		"valid name":                {name: "PMUSER_ORIGIN_2"},
+		"missing prefix":            {name: "ORIGIN", withError: true},
+		"lowercase characters":      {name: "PMUSER_origin", withError: true},
+		"invalid characters":        {name: "PMUSER_ORIGIN-HOST", withError: true},
+		"prefix only":               {name: "PMUSER_", withError: true},
+		"builtin variable rejected": {name: "AK_HOST", withError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validatePropertyVariableName(test.name, cty.Path{})
+			assert.Equal(t, test.withError, diags.HasError())
+		})
+	}
+}
+
+func TestGetPropertyVariables(t *testing.T) {
+	variable := func(name string, hidden, sensitive bool) map[string]interface{} {
+		return map[string]interface{}{"name": name, "value": "v", "hidden": hidden, "sensitive": sensitive}
+	}
+
+	tests := map[string]struct {
+		variables []interface{}
+		expected  []papi.RuleVariable
+		withError string
+	}{
+		"variables are read in order": {
+			variables: []interface{}{variable("PMUSER_B", false, false), variable("PMUSER_A", true, true)},
+			expected: []papi.RuleVariable{
+				{Name: "PMUSER_B", Value: ptr.To("v")},
+				{Name: "PMUSER_A", Value: ptr.To("v"), Hidden: true, Sensitive: true},
+			},
+		},
+		"duplicate names": {
+			variables: []interface{}{variable("PMUSER_A", false, false), variable("PMUSER_A", false, false)},
+			withError: "variable PMUSER_A is declared more than once",
+		},
+		"sensitive variable is not hidden": {
+			variables: []interface{}{variable("PMUSER_A", false, true)},
+			withError: "sensitive variable PMUSER_A must also be hidden",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceProperty().Schema, map[string]interface{}{
+				"rules":    `{"rules":{"name":"default"}}`,
+				"variable": test.variables,
+			})
+			variables, err := getPropertyVariables(d)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, variables)
+		})
+	}
+}
+
+func TestMergeAndExtractPropertyVariables(t *testing.T) {
+	rules := papi.RulesUpdate{Rules: papi.Rules{
+		Name: "default",
+		Variables: []papi.RuleVariable{
+			{Name: "PMUSER_FROM_RULES", Value: ptr.To("rules")},
+			{Name: "PMUSER_SECRET", Value: ptr.To("old")},
+		},
+	}}
+	configured := []papi.RuleVariable{
+		{Name: "PMUSER_SECRET", Value: ptr.To("secret"), Hidden: true, Sensitive: true},
+		{Name: "PMUSER_ORIGIN", Value: ptr.To("origin.example.com"), Description: ptr.To("origin host")},
+	}
+
+	mergePropertyVariables(&rules, configured)
+	assert.Equal(t, []papi.RuleVariable{
+		{Name: "PMUSER_FROM_RULES", Value: ptr.To("rules")},
+		{Name: "PMUSER_SECRET", Value: ptr.To("secret"), Hidden: true, Sensitive: true},
+		{Name: "PMUSER_ORIGIN", Value: ptr.To("origin.example.com"), Description: ptr.To("origin host")},
+	}, rules.Rules.Variables)
+
+	// sensitive values are not returned by the API
+	rules.Rules.Variables[1].Value = ptr.To("")
+	variables := extractPropertyVariables(&rules, configured)
+	assert.Equal(t, []papi.RuleVariable{{Name: "PMUSER_FROM_RULES", Value: ptr.To("rules")}}, rules.Rules.Variables)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "PMUSER_SECRET", "value": "secret", "description": "", "hidden": true, "sensitive": true},
+		map[string]interface{}{"name": "PMUSER_ORIGIN", "value": "origin.example.com", "description": "origin host", "hidden": false, "sensitive": false},
+	}, variables)
+}
@@ -27,9 +27,11 @@ var (
 )
 
 var (
-	client     papi.PAPI
-	hapiClient hapi.HAPI
-	iamClient  iam.IAM
+	client       papi.PAPI
+	hapiClient   hapi.HAPI
+	iamClient    iam.IAM
+	bucketClient hostnameBucket
+	cprgClient   reportingGroups
 )
 
 // NewSubprovider returns a new property subprovider
@@ -61,13 +63,32 @@ func IAMClient(meta meta.Meta) iam.IAM {
 	return iam.Client(meta.Session())
 }
 
+// hostnameBucketClientFor returns the client of PAPI hostname bucket operations
+func hostnameBucketClientFor(meta meta.Meta) hostnameBucket {
+	if bucketClient != nil {
+		return bucketClient
+	}
+	return &hostnameBucketClient{session: meta.Session()}
+}
+
+// reportingGroupsClientFor returns the client of CP code reporting group operations
+func reportingGroupsClientFor(meta meta.Meta) reportingGroups {
+	if cprgClient != nil {
+		return cprgClient
+	}
+	return &reportingGroupsClient{session: meta.Session()}
+}
+
 // SDKResources returns the property resources implemented using terraform-plugin-sdk
 func (p *Subprovider) SDKResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"akamai_cp_code":                     resourceCPCode(),
+		"akamai_cp_code_reporting_group":     resourceCPCodeReportingGroup(),
 		"akamai_edge_hostname":               resourceSecureEdgeHostName(),
 		"akamai_property":                    resourceProperty(),
 		"akamai_property_activation":         resourcePropertyActivation(),
+		"akamai_property_activation_batch":   resourcePropertyActivationBatch(),
+		"akamai_property_hostname_bucket":    resourcePropertyHostnameBucket(),
 		"akamai_property_include":            resourcePropertyInclude(),
 		"akamai_property_include_activation": resourcePropertyIncludeActivation(),
 	}
@@ -76,25 +97,29 @@ func (p *Subprovider) SDKResources() map[string]*schema.Resource {
 // SDKDataSources returns the property data sources implemented using terraform-plugin-sdk
 func (p *Subprovider) SDKDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"akamai_contract":                    dataSourcePropertyContract(),
-		"akamai_contracts":                   dataSourceContracts(),
-		"akamai_cp_code":                     dataSourceCPCode(),
-		"akamai_group":                       dataSourcePropertyGroup(),
-		"akamai_groups":                      dataSourcePropertyMultipleGroups(),
-		"akamai_properties":                  dataSourceProperties(),
-		"akamai_properties_search":           dataSourcePropertiesSearch(),
-		"akamai_property":                    dataSourceProperty(),
-		"akamai_property_activation":         dataSourcePropertyActivation(),
-		"akamai_property_hostnames":          dataSourcePropertyHostnames(),
-		"akamai_property_include_activation": dataSourcePropertyIncludeActivation(),
-		"akamai_property_include_parents":    dataSourcePropertyIncludeParents(),
-		"akamai_property_include_rules":      dataSourcePropertyIncludeRules(),
-		"akamai_property_includes":           dataSourcePropertyIncludes(),
-		"akamai_property_products":           dataSourcePropertyProducts(),
-		"akamai_property_rule_formats":       dataSourcePropertyRuleFormats(),
-		"akamai_property_rules":              dataSourcePropertyRules(),
-		"akamai_property_rules_builder":      dataSourcePropertyRulesBuilder(),
-		"akamai_property_rules_template":     dataSourcePropertyRulesTemplate(),
+		"akamai_contract":                     dataSourcePropertyContract(),
+		"akamai_contracts":                    dataSourceContracts(),
+		"akamai_cp_code":                      dataSourceCPCode(),
+		"akamai_cp_codes_usage":               dataSourceCPCodesUsage(),
+		"akamai_group":                        dataSourcePropertyGroup(),
+		"akamai_groups":                       dataSourcePropertyMultipleGroups(),
+		"akamai_properties":                   dataSourceProperties(),
+		"akamai_properties_search":            dataSourcePropertiesSearch(),
+		"akamai_property":                     dataSourceProperty(),
+		"akamai_property_activation":          dataSourcePropertyActivation(),
+		"akamai_property_hostnames":           dataSourcePropertyHostnames(),
+		"akamai_property_include_activation":  dataSourcePropertyIncludeActivation(),
+		"akamai_property_include_graph":       dataSourcePropertyIncludeGraph(),
+		"akamai_property_include_parents":     dataSourcePropertyIncludeParents(),
+		"akamai_property_include_rules":       dataSourcePropertyIncludeRules(),
+		"akamai_property_includes":            dataSourcePropertyIncludes(),
+		"akamai_property_products":            dataSourcePropertyProducts(),
+		"akamai_property_rule_format_catalog": dataSourcePropertyRuleFormatCatalog(),
+		"akamai_property_rule_formats":        dataSourcePropertyRuleFormats(),
+		"akamai_property_rules":               dataSourcePropertyRules(),
+		"akamai_property_rules_builder":       dataSourcePropertyRulesBuilder(),
+		"akamai_property_rules_merge":         dataSourcePropertyRulesMerge(),
+		"akamai_property_rules_template":      dataSourcePropertyRulesTemplate(),
 	}
 }
 
@@ -0,0 +1,246 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+)
+
+// reportingGroups covers the CP Codes and Reporting Groups API operations, which are not available in the edgegrid client yet.
+// Contract IDs are used without the `ctr_` prefix and group IDs are numbers in this API.
+type reportingGroups interface {
+	// ListReportingGroups lists reporting groups accessible in the given contract and group
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/get-reporting-groups
+	ListReportingGroups(context.Context, listReportingGroupsRequest) ([]reportingGroup, error)
+
+	// GetReportingGroup fetches a single reporting group
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/get-reporting-group
+	GetReportingGroup(context.Context, int) (*reportingGroup, error)
+
+	// CreateReportingGroup creates a reporting group with the given CP codes
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/post-reporting-group
+	CreateReportingGroup(context.Context, reportingGroup) (*reportingGroup, error)
+
+	// UpdateReportingGroup updates the name and CP codes of a reporting group
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/put-reporting-group
+	UpdateReportingGroup(context.Context, reportingGroup) (*reportingGroup, error)
+
+	// DeleteReportingGroup deletes a reporting group
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/delete-reporting-group
+	DeleteReportingGroup(context.Context, int) error
+
+	// ListCPCodes lists CP codes accessible in the given contract and group
+	//
+	// See: https://techdocs.akamai.com/cp-codes/reference/get-cpcodes
+	ListCPCodes(context.Context, listReportingGroupsRequest) ([]cprgCPCode, error)
+}
+
+type (
+	reportingGroupsClient struct {
+		session session.Session
+	}
+
+	listReportingGroupsRequest struct {
+		ContractID string
+		GroupID    int
+	}
+
+	reportingGroup struct {
+		ReportingGroupID   int                      `json:"reportingGroupId,omitempty"`
+		ReportingGroupName string                   `json:"reportingGroupName"`
+		Contracts          []reportingGroupContract `json:"contracts"`
+		AccessGroup        *cprgAccessGroup         `json:"accessGroup,omitempty"`
+	}
+
+	reportingGroupContract struct {
+		ContractID string          `json:"contractId"`
+		CPCodes    []cprgCPCodeRef `json:"cpcodes"`
+	}
+
+	cprgCPCodeRef struct {
+		CPCodeID   int    `json:"cpcodeId"`
+		CPCodeName string `json:"cpcodeName,omitempty"`
+	}
+
+	cprgAccessGroup struct {
+		ContractID string `json:"contractId"`
+		GroupID    int    `json:"groupId"`
+	}
+
+	cprgCPCode struct {
+		CPCodeID    int             `json:"cpcodeId"`
+		CPCodeName  string          `json:"cpcodeName"`
+		Purgeable   bool            `json:"purgeable"`
+		Type        string          `json:"type"`
+		AccessGroup cprgAccessGroup `json:"accessGroup"`
+	}
+)
+
+var (
+	// ErrListReportingGroups is returned when listing reporting groups fails
+	ErrListReportingGroups = errors.New("listing reporting groups")
+	// ErrGetReportingGroup is returned when fetching a reporting group fails
+	ErrGetReportingGroup = errors.New("fetching reporting group")
+	// ErrCreateReportingGroup is returned when creating a reporting group fails
+	ErrCreateReportingGroup = errors.New("creating reporting group")
+	// ErrUpdateReportingGroup is returned when updating a reporting group fails
+	ErrUpdateReportingGroup = errors.New("updating reporting group")
+	// ErrDeleteReportingGroup is returned when deleting a reporting group fails
+	ErrDeleteReportingGroup = errors.New("deleting reporting group")
+	// ErrListCPRGCPCodes is returned when listing CP codes of the reporting groups API fails
+	ErrListCPRGCPCodes = errors.New("listing cp codes")
+)
+
+func (c *reportingGroupsClient) ListReportingGroups(ctx context.Context, params listReportingGroupsRequest) ([]reportingGroup, error) {
+	uri, err := accessGroupURL("/cprg/v1/reporting-groups", params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListReportingGroups, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListReportingGroups, err)
+	}
+
+	var result struct {
+		Groups []reportingGroup `json:"groups"`
+	}
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListReportingGroups, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrListReportingGroups, responseError(resp))
+	}
+
+	return result.Groups, nil
+}
+
+func (c *reportingGroupsClient) GetReportingGroup(ctx context.Context, reportingGroupID int) (*reportingGroup, error) {
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", reportingGroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetReportingGroup, err)
+	}
+
+	var result reportingGroup
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetReportingGroup, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrGetReportingGroup, responseError(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *reportingGroupsClient) CreateReportingGroup(ctx context.Context, group reportingGroup) (*reportingGroup, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/cprg/v1/reporting-groups", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateReportingGroup, err)
+	}
+
+	var result reportingGroup
+	resp, err := c.session.Exec(req, &result, group)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateReportingGroup, err)
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrCreateReportingGroup, responseError(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *reportingGroupsClient) UpdateReportingGroup(ctx context.Context, group reportingGroup) (*reportingGroup, error) {
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", group.ReportingGroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateReportingGroup, err)
+	}
+
+	// the access group cannot be changed
+	body := group
+	body.AccessGroup = nil
+	var result reportingGroup
+	resp, err := c.session.Exec(req, &result, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateReportingGroup, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrUpdateReportingGroup, responseError(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *reportingGroupsClient) DeleteReportingGroup(ctx context.Context, reportingGroupID int) error {
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", reportingGroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteReportingGroup, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteReportingGroup, err)
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %w", ErrDeleteReportingGroup, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *reportingGroupsClient) ListCPCodes(ctx context.Context, params listReportingGroupsRequest) ([]cprgCPCode, error) {
+	uri, err := accessGroupURL("/cprg/v1/cpcodes", params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListCPRGCPCodes, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListCPRGCPCodes, err)
+	}
+
+	var result struct {
+		CPCodes []cprgCPCode `json:"cpcodes"`
+	}
+	resp, err := c.session.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListCPRGCPCodes, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrListCPRGCPCodes, responseError(resp))
+	}
+
+	return result.CPCodes, nil
+}
+
+// accessGroupURL returns the path with the contract and group query parameters
+func accessGroupURL(path string, params listReportingGroupsRequest) (string, error) {
+	uri, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	query := uri.Query()
+	if params.ContractID != "" {
+		query.Add("contractId", params.ContractID)
+	}
+	if params.GroupID != 0 {
+		query.Add("groupId", strconv.Itoa(params.GroupID))
+	}
+	uri.RawQuery = query.Encode()
+	return uri.String(), nil
+}
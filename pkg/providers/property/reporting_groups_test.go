@@ -0,0 +1,123 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReportingGroups struct {
+	mock.Mock
+}
+
+func (m *mockReportingGroups) ListReportingGroups(ctx context.Context, req listReportingGroupsRequest) ([]reportingGroup, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]reportingGroup), args.Error(1)
+}
+
+func (m *mockReportingGroups) GetReportingGroup(ctx context.Context, id int) (*reportingGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reportingGroup), args.Error(1)
+}
+
+func (m *mockReportingGroups) CreateReportingGroup(ctx context.Context, group reportingGroup) (*reportingGroup, error) {
+	args := m.Called(ctx, group)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reportingGroup), args.Error(1)
+}
+
+func (m *mockReportingGroups) UpdateReportingGroup(ctx context.Context, group reportingGroup) (*reportingGroup, error) {
+	args := m.Called(ctx, group)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reportingGroup), args.Error(1)
+}
+
+func (m *mockReportingGroups) DeleteReportingGroup(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockReportingGroups) ListCPCodes(ctx context.Context, req listReportingGroupsRequest) ([]cprgCPCode, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]cprgCPCode), args.Error(1)
+}
+
+func useReportingGroups(cprg reportingGroups, f func()) {
+	orig := cprgClient
+	cprgClient = cprg
+
+	defer func() {
+		cprgClient = orig
+	}()
+
+	f()
+}
+
+func TestReportingGroupsClient(t *testing.T) {
+	t.Run("create reporting group", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/cprg/v1/reporting-groups", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, map[string]interface{}{
+				"reportingGroupName": "finance",
+				"contracts": []interface{}{map[string]interface{}{
+					"contractId": "C-1",
+					"cpcodes":    []interface{}{map[string]interface{}{"cpcodeId": float64(123)}},
+				}},
+				"accessGroup": map[string]interface{}{"contractId": "C-1", "groupId": float64(12)},
+			}, got)
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write([]byte(`{"reportingGroupId":42,"reportingGroupName":"finance"}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		cprg := &reportingGroupsClient{session: mockSession(t, mockServer)}
+		group, err := cprg.CreateReportingGroup(context.Background(), reportingGroup{
+			ReportingGroupName: "finance",
+			Contracts:          []reportingGroupContract{{ContractID: "C-1", CPCodes: []cprgCPCodeRef{{CPCodeID: 123}}}},
+			AccessGroup:        &cprgAccessGroup{ContractID: "C-1", GroupID: 12},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, group.ReportingGroupID)
+	})
+
+	t.Run("list cp codes", func(t *testing.T) {
+		mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/cprg/v1/cpcodes", r.URL.Path)
+			assert.Equal(t, "contractId=C-1&groupId=12", r.URL.RawQuery)
+			_, err := w.Write([]byte(`{"cpcodes":[{"cpcodeId":123,"cpcodeName":"static","purgeable":true}]}`))
+			assert.NoError(t, err)
+		}))
+		defer mockServer.Close()
+
+		cprg := &reportingGroupsClient{session: mockSession(t, mockServer)}
+		cpCodes, err := cprg.ListCPCodes(context.Background(), listReportingGroupsRequest{ContractID: "C-1", GroupID: 12})
+		require.NoError(t, err)
+		assert.Equal(t, []cprgCPCode{{CPCodeID: 123, CPCodeName: "static", Purgeable: true}}, cpCodes)
+	})
+}
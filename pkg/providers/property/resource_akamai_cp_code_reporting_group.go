@@ -0,0 +1,227 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CP Codes and Reporting Groups API
+//
+// https://techdocs.akamai.com/cp-codes/reference/api
+func resourceCPCodeReportingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCPCodeReportingGroupCreate,
+		ReadContext:   resourceCPCodeReportingGroupRead,
+		UpdateContext: resourceCPCodeReportingGroupUpdate,
+		DeleteContext: resourceCPCodeReportingGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "Name of the reporting group",
+			},
+			"contract_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("ctr_"),
+				Description: "Contract of the CP codes and the access group of the reporting group",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("grp_"),
+				Description: "Access group of the reporting group",
+			},
+			"cp_code_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: tf.IsNotBlank},
+				Description: "IDs of the CP codes in the reporting group, with or without the `cpc_` prefix",
+			},
+		},
+	}
+}
+
+func resourceCPCodeReportingGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := reportingGroupsClientFor(meta)
+	logger := meta.Log("PAPI", "resourceCPCodeReportingGroupCreate")
+	logger.Debug("Creating CP code reporting group")
+
+	group, err := reportingGroupFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	created, err := client.CreateReportingGroup(ctx, *group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.Itoa(created.ReportingGroupID))
+
+	return resourceCPCodeReportingGroupRead(ctx, d, m)
+}
+
+func resourceCPCodeReportingGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := reportingGroupsClientFor(meta)
+	logger := meta.Log("PAPI", "resourceCPCodeReportingGroupRead")
+	logger.Debug("Reading CP code reporting group")
+
+	reportingGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid reporting group id %q: %s", d.Id(), err)
+	}
+
+	group, err := client.GetReportingGroup(ctx, reportingGroupID)
+	if err != nil {
+		var apiErr *papi.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			logger.Warnf("reporting group %d not found, removing from state", reportingGroupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	attrs := map[string]interface{}{
+		"name":        group.ReportingGroupName,
+		"cp_code_ids": flattenReportingGroupCPCodes(d, group),
+	}
+	if group.AccessGroup != nil {
+		attrs["contract_id"] = str.AddPrefix(group.AccessGroup.ContractID, "ctr_")
+		attrs["group_id"] = str.AddPrefix(strconv.Itoa(group.AccessGroup.GroupID), "grp_")
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCPCodeReportingGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := reportingGroupsClientFor(meta)
+	logger := meta.Log("PAPI", "resourceCPCodeReportingGroupUpdate")
+	logger.Debug("Updating CP code reporting group")
+
+	group, err := reportingGroupFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if group.ReportingGroupID, err = strconv.Atoi(d.Id()); err != nil {
+		return diag.Errorf("invalid reporting group id %q: %s", d.Id(), err)
+	}
+
+	if _, err := client.UpdateReportingGroup(ctx, *group); err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	return resourceCPCodeReportingGroupRead(ctx, d, m)
+}
+
+func resourceCPCodeReportingGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := reportingGroupsClientFor(meta)
+	logger := meta.Log("PAPI", "resourceCPCodeReportingGroupDelete")
+	logger.Debug("Deleting CP code reporting group")
+
+	reportingGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid reporting group id %q: %s", d.Id(), err)
+	}
+
+	if err := client.DeleteReportingGroup(ctx, reportingGroupID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// reportingGroupFromResourceData builds the reporting group in the format of the reporting groups API
+func reportingGroupFromResourceData(d *schema.ResourceData) (*reportingGroup, error) {
+	name, err := tf.GetStringValue("name", d)
+	if err != nil {
+		return nil, err
+	}
+	contractID, err := tf.GetStringValue("contract_id", d)
+	if err != nil {
+		return nil, err
+	}
+	contractID = strings.TrimPrefix(contractID, "ctr_")
+	groupIDValue, err := tf.GetStringValue("group_id", d)
+	if err != nil {
+		return nil, err
+	}
+	groupID, err := str.GetIntID(groupIDValue, "grp_")
+	if err != nil {
+		return nil, fmt.Errorf("invalid group id %q: %s", groupIDValue, err)
+	}
+	cpCodeIDs, err := tf.GetSetValue("cp_code_ids", d)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := reportingGroupContract{ContractID: contractID}
+	for _, id := range tf.SetToStringSlice(cpCodeIDs) {
+		cpCodeID, err := str.GetIntID(id, cpCodePrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cp code id %q: %s", id, err)
+		}
+		contract.CPCodes = append(contract.CPCodes, cprgCPCodeRef{CPCodeID: cpCodeID})
+	}
+	sort.Slice(contract.CPCodes, func(i, j int) bool {
+		return contract.CPCodes[i].CPCodeID < contract.CPCodes[j].CPCodeID
+	})
+
+	return &reportingGroup{
+		ReportingGroupName: name,
+		Contracts:          []reportingGroupContract{contract},
+		AccessGroup:        &cprgAccessGroup{ContractID: contractID, GroupID: groupID},
+	}, nil
+}
+
+// flattenReportingGroupCPCodes returns IDs of the reporting group CP codes.
+// IDs are written in the same form as in the configuration, so that the `cpc_` prefix does not cause a diff.
+func flattenReportingGroupCPCodes(d *schema.ResourceData, group *reportingGroup) []string {
+	prefixed := make(map[int]bool)
+	if set, ok := d.Get("cp_code_ids").(*schema.Set); ok {
+		for _, id := range tf.SetToStringSlice(set) {
+			if cpCodeID, err := str.GetIntID(id, cpCodePrefix); err == nil {
+				prefixed[cpCodeID] = strings.HasPrefix(id, cpCodePrefix)
+			}
+		}
+	}
+
+	var ids []string
+	for _, contract := range group.Contracts {
+		for _, cpCode := range contract.CPCodes {
+			id := strconv.Itoa(cpCode.CPCodeID)
+			if prefixed[cpCode.CPCodeID] {
+				id = cpCodePrefix + id
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
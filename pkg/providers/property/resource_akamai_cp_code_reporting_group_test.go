@@ -0,0 +1,81 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResCPCodeReportingGroup(t *testing.T) {
+	accessGroup := &cprgAccessGroup{ContractID: "C-1", GroupID: 12}
+	group := func(id int, name string, cpCodeIDs ...int) reportingGroup {
+		contract := reportingGroupContract{ContractID: "C-1"}
+		for _, cpCodeID := range cpCodeIDs {
+			contract.CPCodes = append(contract.CPCodes, cprgCPCodeRef{CPCodeID: cpCodeID})
+		}
+		return reportingGroup{ReportingGroupID: id, ReportingGroupName: name, Contracts: []reportingGroupContract{contract}, AccessGroup: accessGroup}
+	}
+	created, updated := group(42, "finance", 123, 456), group(42, "finance and billing", 123, 789)
+
+	tests := map[string]struct {
+		init  func(*mockReportingGroups)
+		steps []resource.TestStep
+	}{
+		"create, update and delete reporting group": {
+			init: func(m *mockReportingGroups) {
+				// create
+				m.On("CreateReportingGroup", mock.Anything, group(0, "finance", 123, 456)).Return(&created, nil).Once()
+				m.On("GetReportingGroup", mock.Anything, 42).Return(&created, nil).Times(3)
+				// update
+				m.On("UpdateReportingGroup", mock.Anything, group(42, "finance and billing", 123, 789)).Return(&updated, nil).Once()
+				m.On("GetReportingGroup", mock.Anything, 42).Return(&updated, nil)
+				// delete
+				m.On("DeleteReportingGroup", mock.Anything, 42).Return(nil).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cp_code_reporting_group.finance", "id", "42"),
+						resource.TestCheckResourceAttr("akamai_cp_code_reporting_group.finance", "contract_id", "ctr_C-1"),
+						resource.TestCheckResourceAttr("akamai_cp_code_reporting_group.finance", "group_id", "grp_12"),
+						resource.TestCheckResourceAttr("akamai_cp_code_reporting_group.finance", "cp_code_ids.#", "2"),
+						resource.TestCheckTypeSetElemAttr("akamai_cp_code_reporting_group.finance", "cp_code_ids.*", "cpc_123"),
+						resource.TestCheckTypeSetElemAttr("akamai_cp_code_reporting_group.finance", "cp_code_ids.*", "456"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cp_code_reporting_group.finance", "name", "finance and billing"),
+						resource.TestCheckTypeSetElemAttr("akamai_cp_code_reporting_group.finance", "cp_code_ids.*", "cpc_789"),
+					),
+				},
+				{
+					ImportState:       true,
+					ImportStateId:     "42",
+					ResourceName:      "akamai_cp_code_reporting_group.finance",
+					ImportStateVerify: true,
+					// imported CP code IDs have no prefix
+					ImportStateVerifyIgnore: []string{"cp_code_ids"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockReportingGroups{}
+			test.init(client)
+			useReportingGroups(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps:                    test.steps,
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/timeouts"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
 	"github.com/apex/log"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceProperty() *schema.Resource {
@@ -57,11 +59,15 @@ func resourceProperty() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			hostNamesCustomDiff,
 			propertyRulesCustomDiff,
+			validatePropertyVariables,
 			setPropertyVersionsComputed,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
 		StateUpgraders: []schema.StateUpgrader{{
 			Version: 0,
 			Type:    resourcePropertyV0().CoreConfigSchema().ImpliedType(),
@@ -99,6 +105,39 @@ func resourceProperty() *schema.Resource {
 				Optional:    true,
 				Description: "Property ID",
 			},
+			"clone_from": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"property_id"},
+				Description:   "Creates the property as a copy of an existing property version, keeping its rule tree and rule format",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: tf.IsNotBlank,
+							Description:      "The ID of the property to clone",
+						},
+						"version": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "The version of the property to clone",
+						},
+						"copy_hostnames": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Whether to copy the hostnames of the cloned version. Hostnames set in the `hostnames` attribute replace the copied ones",
+						},
+					},
+				},
+			},
 			"rule_format": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -115,6 +154,7 @@ func resourceProperty() *schema.Resource {
 				DiffSuppressFunc: diffSuppressPropertyRules,
 				StateFunc:        rulesStateFunc,
 			},
+			"variable": propertyVariablesSchema,
 			"version_notes": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -212,6 +252,65 @@ func resourceProperty() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the property in the Identity and Access Management API.",
 			},
+			"rules_etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ETag of the latest version's rule tree, used to detect changes made outside of Terraform",
+			},
+			"overwrite_out_of_band_changes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to apply changes even if the property was changed outside of Terraform since the last refresh",
+			},
+			"certificate_challenges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Domain validation challenges of DEFAULT provisioned hostnames",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cname_from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname the certificate is issued for",
+						},
+						"cname_hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the CNAME record validating the domain",
+						},
+						"cname_target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The target of the CNAME record validating the domain",
+						},
+						"http_redirect_from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL to redirect when validating the domain with an HTTP token instead of the CNAME record",
+						},
+						"http_redirect_to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL the HTTP token validation has to be redirected to",
+						},
+					},
+				},
+			},
+			"timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enables to set timeout for processing",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: timeouts.ValidateDurationFormat,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -243,8 +342,14 @@ func validateNameWithBound(lowerBound int) func(v interface{}, _ cty.Path) diag.
 	}
 }
 
-// ErrCalculatingHostnamesHash is used when calculating hash value for set of hostnames failed.
-var ErrCalculatingHostnamesHash = errors.New("calculating hostnames set hash failed")
+var (
+	// ErrCalculatingHostnamesHash is used when calculating hash value for set of hostnames failed.
+	ErrCalculatingHostnamesHash = errors.New("calculating hostnames set hash failed")
+	// ErrCertificatesNotDeployed is used when certificates of DEFAULT provisioned hostnames are not deployed in time.
+	ErrCertificatesNotDeployed = errors.New("waiting for certificates failed")
+	// ErrOutOfBandChange is used when the property was changed outside of Terraform since the last refresh.
+	ErrOutOfBandChange = errors.New("property was changed outside of Terraform, refresh the state or set 'overwrite_out_of_band_changes' to apply the changes anyway")
+)
 
 func hashHostname(v any) int {
 	m, ok := v.(map[string]any)
@@ -375,7 +480,8 @@ func hostNamesCustomDiff(_ context.Context, d *schema.ResourceDiff, m interface{
 // Things that might trigger creating a new property version:
 //   - updating hostnames,
 //   - updating property rules (excluding comments if version_notes are set)
-//   - updating rule_format, updating rules.
+//   - updating rule_format, updating rules,
+//   - updating variable blocks.
 //
 // To properly recognize version_notes being removed from config, use rd
 // implementation which uses raw config.
@@ -385,7 +491,7 @@ func canTriggerNewPropertyVersion(rc tf.ResourceChangeFetcher, rd tf.ResourceDat
 		return true, nil
 	}
 
-	if rc.HasChange("rule_format") {
+	if rc.HasChange("rule_format") || rc.HasChange("variable") {
 		return true, nil
 	}
 
@@ -428,6 +534,9 @@ func setPropertyVersionsComputed(_ context.Context, rd *schema.ResourceDiff, _ i
 	if err := rd.SetNewComputed("latest_version"); err != nil {
 		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
 	}
+	if err := rd.SetNewComputed("rules_etag"); err != nil {
+		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+	}
 
 	return nil
 }
@@ -475,8 +584,13 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	ruleFormat := d.Get("rule_format").(string)
 
+	cloneFrom, err := getPropertyCloneFrom(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if propertyID == "" {
-		propertyID, err = createProperty(ctx, client, propertyName, groupID, contractID, productID, ruleFormat)
+		propertyID, err = createProperty(ctx, client, propertyName, groupID, contractID, productID, ruleFormat, cloneFrom)
 		if err != nil {
 			return interpretCreatePropertyError(ctx, err, client, groupID, contractID, productID)
 		}
@@ -520,8 +634,14 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 			d.Partial(true)
 			return diag.FromErr(err)
 		}
+		variables, err := getPropertyVariables(d)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		mergePropertyVariables(&rulesUpdate, variables)
 
-		if err := updatePropertyRules(ctx, client, property, rulesUpdate, ruleFormat); err != nil {
+		if err := updatePropertyRules(ctx, client, property, rulesUpdate, ruleFormat, ""); err != nil {
 			d.Partial(true)
 			return diag.FromErr(err)
 		}
@@ -597,10 +717,14 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	rules, ruleFormat, ruleErrors, ruleWarnings, err := fetchPropertyVersionRules(ctx, client, *property, v)
+	rules, ruleFormat, ruleErrors, ruleWarnings, rulesEtag, err := fetchPropertyVersionRules(ctx, client, *property, v)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if readVersionID != 0 {
+		// changes are applied to a new version created from read_version, so there is nothing to guard
+		rulesEtag = ""
+	}
 
 	if len(ruleErrors) > 0 {
 		if err := d.Set("rule_errors", papiErrorsToList(ruleErrors)); err != nil {
@@ -632,6 +756,12 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	configuredVariables, err := getPropertyVariables(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	variables := extractPropertyVariables(&rules, configuredVariables)
+
 	rulesJSON, err := json.Marshal(rules)
 	if err != nil {
 		logger.WithError(err).Error("could not render rules as JSON")
@@ -639,19 +769,22 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	attrs := map[string]interface{}{
-		"asset_id":           property.AssetID,
-		"name":               property.PropertyName,
-		"group_id":           property.GroupID,
-		"contract_id":        property.ContractID,
-		"latest_version":     property.LatestVersion,
-		"staging_version":    stagingVersion,
-		"production_version": productionVersion,
-		"hostnames":          flattenHostnames(hostnames),
-		"rules":              string(rulesJSON),
-		"rule_format":        ruleFormat,
-		"rule_errors":        papiErrorsToList(ruleErrors),
-		"read_version":       readVersionID,
-		"version_notes":      res.Version.Note,
+		"asset_id":               property.AssetID,
+		"name":                   property.PropertyName,
+		"group_id":               property.GroupID,
+		"contract_id":            property.ContractID,
+		"latest_version":         property.LatestVersion,
+		"staging_version":        stagingVersion,
+		"production_version":     productionVersion,
+		"hostnames":              flattenHostnames(hostnames),
+		"certificate_challenges": flattenCertificateChallenges(hostnames),
+		"rules":                  string(rulesJSON),
+		"rule_format":            ruleFormat,
+		"rule_errors":            papiErrorsToList(ruleErrors),
+		"read_version":           readVersionID,
+		"version_notes":          res.Version.Note,
+		"rules_etag":             rulesEtag,
+		"variable":               variables,
 	}
 	if res.Version.ProductID != "" {
 		attrs["product_id"] = res.Version.ProductID
@@ -689,9 +822,9 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	// We only update if these attributes change.
-	if !d.HasChanges("hostnames", "rules", "rule_format") {
+	if !d.HasChanges("hostnames", "rules", "rule_format", "variable") {
 		logger.Debug(
-			"No changes to hostnames, rules, or rule_format (no update required)")
+			"No changes to hostnames, rules, rule_format or variable (no update required)")
 		return nil
 	}
 
@@ -746,12 +879,18 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.FromErr(err)
 		}
 
-		if !d.HasChanges("hostnames", "rules", "rule_format") {
+		if !d.HasChanges("hostnames", "rules", "rule_format", "variable") {
 			logger.Debug("Only group_id changed, exiting early")
 			return resourcePropertyRead(ctx, d, m)
 		}
 	}
 
+	rulesEtag, err := checkOutOfBandChanges(ctx, client, property, d)
+	if err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
 	var propertyVersion int
 	if v, ok := d.GetOk("read_version"); ok && v.(int) != 0 {
 		propertyVersion = v.(int)
@@ -774,6 +913,8 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.FromErr(err)
 		}
 		property.LatestVersion = versionID
+		// the rule tree of a fresh version cannot be changed by anyone else yet
+		rulesEtag = ""
 		if err = d.Set("read_version", 0); err != nil {
 			return diag.FromErr(err)
 		}
@@ -795,7 +936,7 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	if shouldUpdateRuleTree(d) {
-		if err := updateRuleTree(ctx, client, property, d); err != nil {
+		if err := updateRuleTree(ctx, client, property, d, rulesEtag); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -804,7 +945,7 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func updateRuleTree(ctx context.Context, client papi.PAPI, property papi.Property,
-	d *schema.ResourceData) error {
+	d *schema.ResourceData, etag string) error {
 	ruleFormat, err := tf.GetStringValue("rule_format", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
 		return err
@@ -825,8 +966,14 @@ func updateRuleTree(ctx context.Context, client papi.PAPI, property papi.Propert
 		d.Partial(true)
 		return err
 	}
+	variables, err := getPropertyVariables(d)
+	if err != nil {
+		d.Partial(true)
+		return err
+	}
+	mergePropertyVariables(&rulesUpdate, variables)
 
-	if err := updatePropertyRules(ctx, client, property, rulesUpdate, ruleFormat); err != nil {
+	if err := updatePropertyRules(ctx, client, property, rulesUpdate, ruleFormat, etag); err != nil {
 		d.Partial(true)
 		return err
 	}
@@ -834,6 +981,44 @@ func updateRuleTree(ctx context.Context, client papi.PAPI, property papi.Propert
 	return nil
 }
 
+// checkOutOfBandChanges verifies that neither a new version was created nor the rule tree of the latest version was changed
+// since the last refresh. It returns the ETag of the latest version's rule tree to be sent with the rule update.
+// The check is skipped when overwrite_out_of_band_changes is set or when no ETag was recorded yet.
+func checkOutOfBandChanges(ctx context.Context, client papi.PAPI, property papi.Property, d *schema.ResourceData) (string, error) {
+	if overwrite, ok := d.GetOk("overwrite_out_of_band_changes"); ok && overwrite.(bool) {
+		return "", nil
+	}
+	oldEtag, _ := d.GetChange("rules_etag")
+	if oldEtag.(string) == "" {
+		return "", nil
+	}
+	oldLatestVersion, _ := d.GetChange("latest_version")
+
+	latest, err := fetchLatestProperty(ctx, client, property.PropertyID, property.GroupID, property.ContractID)
+	if err != nil {
+		return "", err
+	}
+	if latest.LatestVersion != oldLatestVersion.(int) {
+		return "", fmt.Errorf("%w: version %d was created while the latest known version is %d", ErrOutOfBandChange,
+			latest.LatestVersion, oldLatestVersion.(int))
+	}
+
+	res, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+		PropertyID:      property.PropertyID,
+		GroupID:         property.GroupID,
+		ContractID:      property.ContractID,
+		PropertyVersion: latest.LatestVersion,
+	})
+	if err != nil {
+		return "", err
+	}
+	if res.Etag != oldEtag.(string) {
+		return "", fmt.Errorf("%w: rules of version %d were modified", ErrOutOfBandChange, latest.LatestVersion)
+	}
+
+	return res.Etag, nil
+}
+
 func resourcePropertyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ctx = log.NewContext(ctx, meta.Must(m).Log("PAPI", "resourcePropertyDelete"))
 	logger := log.FromContext(ctx)
@@ -976,7 +1161,9 @@ func parseVersionNumber(version string) (int, error) {
 	return versionNumber, err
 }
 
-func createProperty(ctx context.Context, client papi.PAPI, propertyName, groupID, contractID, productID, ruleFormat string) (string, error) {
+// createProperty creates a new property. When cloneFrom is given, the property starts as a copy of that property version
+// instead of a blank rule tree.
+func createProperty(ctx context.Context, client papi.PAPI, propertyName, groupID, contractID, productID, ruleFormat string, cloneFrom *papi.PropertyCloneFrom) (string, error) {
 	req := papi.CreatePropertyRequest{
 		ContractID: contractID,
 		GroupID:    groupID,
@@ -984,6 +1171,7 @@ func createProperty(ctx context.Context, client papi.PAPI, propertyName, groupID
 			ProductID:    productID,
 			PropertyName: propertyName,
 			RuleFormat:   ruleFormat,
+			CloneFrom:    cloneFrom,
 		},
 	}
 
@@ -1009,6 +1197,31 @@ func createProperty(ctx context.Context, client papi.PAPI, propertyName, groupID
 	return "", err
 }
 
+// getPropertyCloneFrom returns the property version configured in the clone_from block or nil when the block is not set
+func getPropertyCloneFrom(d *schema.ResourceData) (*papi.PropertyCloneFrom, error) {
+	cloneFrom, err := tf.GetListValue("clone_from", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(cloneFrom) == 0 || cloneFrom[0] == nil {
+		return nil, nil
+	}
+
+	cloneFromMap, ok := cloneFrom[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: clone_from, %q", tf.ErrInvalidType, "map[string]interface{}")
+	}
+
+	return &papi.PropertyCloneFrom{
+		PropertyID:    str.AddPrefix(cloneFromMap["property_id"].(string), "prp_"),
+		Version:       cloneFromMap["version"].(int),
+		CopyHostnames: cloneFromMap["copy_hostnames"].(bool),
+	}, nil
+}
+
 func interpretCreatePropertyBadRequest(ctx context.Context, client papi.PAPI, req papi.CreatePropertyRequest) error {
 	if _, err := getGroup(ctx, client, req.GroupID); err != nil {
 		if errors.Is(err, ErrGroupNotFound) {
@@ -1260,7 +1473,7 @@ func fetchPropertyVersionHostnames(ctx context.Context, client papi.PAPI, proper
 	return res.Hostnames.Items, nil
 }
 
-func fetchPropertyVersionRules(ctx context.Context, client papi.PAPI, property papi.Property, version int) (rules papi.RulesUpdate, format string, errors, warnings []*papi.Error, err error) {
+func fetchPropertyVersionRules(ctx context.Context, client papi.PAPI, property papi.Property, version int) (rules papi.RulesUpdate, format string, errors, warnings []*papi.Error, etag string, err error) {
 	req := papi.GetRuleTreeRequest{
 		PropertyID:      property.PropertyID,
 		GroupID:         property.GroupID,
@@ -1287,6 +1500,7 @@ func fetchPropertyVersionRules(ctx context.Context, client papi.PAPI, property p
 	format = res.RuleFormat
 	errors = res.Errors
 	warnings = res.Warnings
+	etag = res.Etag
 	return
 }
 
@@ -1294,7 +1508,7 @@ func shouldUpdateRuleTree(rd *schema.ResourceData) bool {
 	rules, _ := rd.GetOk("rules")
 	format, _ := rd.GetOk("rule_format")
 
-	rulesNeedUpdate := rules != nil && rd.HasChange("rules")
+	rulesNeedUpdate := rules != nil && (rd.HasChange("rules") || rd.HasChange("variable"))
 	formatNeedsUpdate := format != nil && rd.HasChange("rule_format")
 
 	return rulesNeedUpdate || formatNeedsUpdate
@@ -1315,7 +1529,9 @@ func newRulesUpdate(rulesJSON, comments string) (papi.RulesUpdate, error) {
 	return rules, nil
 }
 
-func updatePropertyRules(ctx context.Context, client papi.PAPI, property papi.Property, rules papi.RulesUpdate, ruleFormat string) error {
+// updatePropertyRules updates rules of the latest version of the given property.
+// When etag is not empty, the update is only applied if the rule tree has not changed since the etag was fetched.
+func updatePropertyRules(ctx context.Context, client papi.PAPI, property papi.Property, rules papi.RulesUpdate, ruleFormat, etag string) error {
 	logger := log.FromContext(ctx)
 
 	req := papi.UpdateRulesRequest{
@@ -1327,9 +1543,15 @@ func updatePropertyRules(ctx context.Context, client papi.PAPI, property papi.Pr
 		ValidateRules:   true,
 	}
 
+	h := http.Header{}
 	if ruleFormat != "" {
 		MIME := fmt.Sprintf("application/vnd.akamai.papirules.%s+json", ruleFormat)
-		h := http.Header{"Content-Type": []string{MIME}}
+		h.Set("Content-Type", MIME)
+	}
+	if etag != "" {
+		h.Set("If-Match", etag)
+	}
+	if len(h) > 0 {
 		ctx = session.ContextWithOptions(ctx, session.WithContextHeaders(h))
 	}
 
@@ -1337,6 +1559,10 @@ func updatePropertyRules(ctx context.Context, client papi.PAPI, property papi.Pr
 	res, err := client.UpdateRuleTree(ctx, req)
 	if err != nil {
 		logger.WithError(err).Error("could not update property rules")
+		var apiErr *papi.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed {
+			return fmt.Errorf("%w: rules of version %d were modified: %s", ErrOutOfBandChange, property.LatestVersion, err)
+		}
 		return err
 	}
 
@@ -1411,6 +1637,24 @@ func updatePropertyHostnames(ctx context.Context, client papi.PAPI, property pap
 	return nil
 }
 
+// flattenCertificateChallenges returns domain validation challenges of DEFAULT provisioned hostnames
+func flattenCertificateChallenges(hostnames []papi.Hostname) []map[string]interface{} {
+	var challenges []map[string]interface{}
+	for _, h := range hostnames {
+		if h.CertProvisioningType != certProvisioningTypeDefault {
+			continue
+		}
+		challenges = append(challenges, map[string]interface{}{
+			"cname_from":         h.CnameFrom,
+			"cname_hostname":     h.CertStatus.ValidationCname.Hostname,
+			"cname_target":       h.CertStatus.ValidationCname.Target,
+			"http_redirect_from": fmt.Sprintf("http://%s/.well-known/acme-challenge/", h.CnameFrom),
+			"http_redirect_to":   "http://dcv.akamai.com/.well-known/acme-challenge/",
+		})
+	}
+	return challenges
+}
+
 // mapToHostnames converts the given map from a schema.ResourceData to a slice of papi.Hostnames input to papi request.
 func mapToHostnames(givenList []interface{}) []papi.Hostname {
 	var hostnames []papi.Hostname
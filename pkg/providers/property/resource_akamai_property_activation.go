@@ -13,6 +13,7 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/date"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/schedule"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/timeouts"
@@ -33,7 +34,8 @@ func resourcePropertyActivation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyActivationImport,
 		},
-		Schema: akamaiPropertyActivationSchema,
+		CustomizeDiff: setActivationNextEligibleTime,
+		Schema:        akamaiPropertyActivationSchema,
 		Timeouts: &schema.ResourceTimeout{
 			Default: &PropertyResourceTimeout,
 		},
@@ -92,6 +94,9 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Automatically acknowledge all rule warnings for activation to continue. Default is false",
 	},
+	"lint_fail_on": lintFailOnSchema("The lowest severity of akamai_property_rules_lint findings in the rules of the activated " +
+		"version which stops the activation. 'none' skips linting"),
+	"lint_policy": lintPolicySchema(),
 	"version": {
 		Type:             schema.TypeInt,
 		Required:         true,
@@ -111,6 +116,16 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"previous_version": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The version which was active on the network before the last activation of this resource. Zero when there was none",
+	},
+	"rollback": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When true, the version recorded in 'previous_version' is reactivated instead of 'version'",
+	},
 	"note": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -124,6 +139,16 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Description: "Provides an audit record when activating on a production network",
 		Elem:        complianceRecordSchema,
 	},
+	"not_before":         schedule.NotBeforeSchema(),
+	"window":             schedule.WindowSchema(),
+	"next_eligible_time": schedule.NextEligibleTimeSchema(),
+	"verification":       verificationSchema,
+	"wait_for_certificates": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Waits after the activation until certificates of the DEFAULT provisioned hostnames of the activated version are deployed on the network",
+	},
 	"timeouts": {
 		Type:        schema.TypeList,
 		Optional:    true,
@@ -170,6 +195,10 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		logger.Debugf("activation create with deadline in %s", time.Until(dead).String())
 	}
 
+	if d.Get("rollback").(bool) {
+		return diag.FromErr(fmt.Errorf("%w: rollback can only be applied to an existing activation", errNoPreviousVersion))
+	}
+
 	propertyID, err := resolvePropertyID(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -187,9 +216,6 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
-
 	// check to see if this tree has any issues
 	rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
 		PropertyID:      propertyID,
@@ -207,10 +233,9 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		d.Partial(true)
 		return diags
 	}
-
-	complianceRecord, err := tf.GetListValue("compliance_record", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return diag.FromErr(err)
+	if diags := checkRulesLint(rules, d, logger); diags.HasError() {
+		d.Partial(true)
+		return diags
 	}
 
 	activation, err := lookupActivation(ctx, client, lookupActivationRequest{
@@ -225,36 +250,31 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	// remember the version active before this activation, it is the target of a rollback
+	var previousVersion int
+	if activation != nil && activation.ActivationType == papi.ActivationTypeActivate &&
+		activation.Status == papi.ActivationStatusActive && activation.PropertyVersion != version {
+		previousVersion = activation.PropertyVersion
+	}
+
 	// we create a new property activation in case of no previous activation, or deleted activation
 	if activation == nil || activation.ActivationType == papi.ActivationTypeDeactivate || activation.PropertyVersion != version {
-		notifySet, err := tf.GetSetValue("contact", d)
-		if err != nil {
+		note, err := tf.GetStringValue("note", d)
+		if err != nil && !errors.Is(err, tf.ErrNotFound) {
 			return diag.FromErr(err)
 		}
-		var notify []string
-		for _, contact := range notifySet.List() {
-			notify = append(notify, cast.ToString(contact))
-		}
 
-		note, err := tf.GetStringValue("note", d)
-		if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		createActivationRequest, err := newActivationRequest(d, propertyID, network, version, note, false)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		createActivationRequest := papi.CreateActivationRequest{
-			PropertyID: propertyID,
-			Activation: papi.Activation{
-				ActivationType:         papi.ActivationTypeActivate,
-				Network:                network,
-				PropertyVersion:        version,
-				NotifyEmails:           notify,
-				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
-				Note:                   note,
-			},
+		if err := schedule.WaitFor(ctx, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
 
 		logger.Debug("creating activation")
-		activationID, diagErr := createActivation(ctx, client, addPropertyComplianceRecord(complianceRecord, createActivationRequest))
+		activationID, diagErr := createActivation(ctx, client, createActivationRequest)
 		if diagErr != nil {
 			return diagErr
 		}
@@ -279,11 +299,16 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 	if diagErr != nil {
 		return diagErr
 	}
+	// the activation is not in state yet, so it is adopted and waited for again on the next apply
+	if err := waitForActivationCertificates(ctx, d, client, propertyID, version, network); err != nil {
+		return diag.FromErr(err)
+	}
 
 	attrs := map[string]interface{}{
-		"status":        string(activation.Status),
-		"activation_id": activation.ActivationID,
-		"version":       version,
+		"status":           string(activation.Status),
+		"activation_id":    activation.ActivationID,
+		"version":          version,
+		"previous_version": previousVersion,
 	}
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -291,7 +316,13 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(propertyID + ":" + string(network))
 
-	return nil
+	verifyDiags := verifyActivation(ctx, d, client, propertyID, network, previousVersion)
+	if verifyDiags.HasError() {
+		// a rollback was attempted. An error with the ID set would taint the resource and its replacement
+		// would deactivate the property, so the activation is left out of state and repeated on the next apply
+		d.SetId("")
+	}
+	return verifyDiags
 }
 
 func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -324,6 +355,10 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// after a rollback it is the previous version which is active
+	if previousVersion := d.Get("previous_version").(int); d.Get("rollback").(bool) && previousVersion > 0 {
+		version = previousVersion
+	}
 
 	complianceRecord, err := tf.GetListValue("compliance_record", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
@@ -496,6 +531,10 @@ func resourcePropertyActivationRead(ctx context.Context, d *schema.ResourceData,
 		"note":          activation.Note,
 		"contact":       activation.NotifyEmails,
 	}
+	// while rolled back, the configured version is kept in state so that no new activation is planned
+	if d.Get("rollback").(bool) && activation.PropertyVersion == d.Get("previous_version").(int) {
+		attrs["version"] = d.Get("version").(int)
+	}
 
 	if err = tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -505,7 +544,26 @@ func resourcePropertyActivationRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-var errNoActiveVersionFound = errors.New("activation not found")
+var (
+	errNoActiveVersionFound = errors.New("activation not found")
+	errNoPreviousVersion    = errors.New("no previously active version to roll back to")
+)
+
+// networkActiveVersion returns the version of the property active on the network, or zero when there is none
+func networkActiveVersion(ctx context.Context, client papi.PAPI, propertyID string, network papi.ActivationNetwork) (int, error) {
+	resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{PropertyID: propertyID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get activations for property %s: %w", propertyID, err)
+	}
+	active, err := findLatestActive(resp.Activations.Items, network)
+	if errors.Is(err, errNoActiveVersionFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unexpected error searching for latest activation: %w", err)
+	}
+	return active.PropertyVersion, nil
+}
 
 func findLatestActive(activations []*papi.Activation, network papi.ActivationNetwork) (*papi.Activation, error) {
 	if len(activations) == 0 {
@@ -563,8 +621,8 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		session.WithContextLog(logger),
 	)
 
-	if !d.HasChangesExcept("timeouts", "compliance_record") {
-		logger.Debug("Only timeouts and/or compliance_record were updated, update with no API calls")
+	if !d.HasChangesExcept("timeouts", "compliance_record", "not_before", "window", "next_eligible_time", "verification", "lint_fail_on", "lint_policy", "wait_for_certificates") {
+		logger.Debug("Only timeouts, compliance_record, schedule, verification, lint settings and/or wait_for_certificates were updated, update with no API calls")
 		return nil
 	}
 
@@ -586,24 +644,28 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	complianceRecord, err := tf.GetListValue("compliance_record", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return diag.FromErr(err)
-	}
-
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
-
 	// Assigns a log message to the activation request
 	note, err := tf.GetStringValue("note", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
 		return diag.FromErr(err)
 	}
 
+	// in rollback mode the previously active version is activated instead of the configured one
+	activateVersion := version
+	previousVersion := d.Get("previous_version").(int)
+	rollback := d.Get("rollback").(bool)
+	if rollback {
+		if previousVersion == 0 {
+			return diag.FromErr(fmt.Errorf("%w: property %s has no version recorded as previously active on %s", errNoPreviousVersion, propertyID, network))
+		}
+		activateVersion = previousVersion
+		note = fmt.Sprintf("Rollback of %s on %s from version %d to version %d", propertyID, network, version, previousVersion)
+	}
+
 	// check to see if this tree has any issues
 	rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
 		PropertyID:      propertyID,
-		PropertyVersion: version,
+		PropertyVersion: activateVersion,
 		ValidateRules:   true,
 	})
 	if err != nil {
@@ -618,9 +680,25 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		d.Partial(true)
 		return diags
 	}
+	if diags := checkRulesLint(rules, d, logger); diags.HasError() {
+		d.Partial(true)
+		return diags
+	}
+
+	// remember the version active on the network before this activation, it is the target of a rollback
+	if !rollback {
+		active, err := networkActiveVersion(ctx, client, propertyID, network)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if active != activateVersion {
+			previousVersion = active
+		}
+	}
+
 	propertyActivation, err := lookupActivation(ctx, client, lookupActivationRequest{
 		propertyID: propertyID,
-		version:    version,
+		version:    activateVersion,
 		network:    network,
 		activationType: map[papi.ActivationType]struct{}{
 			papi.ActivationTypeActivate: {},
@@ -630,34 +708,22 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	versionStatus, err := resolveVersionStatus(ctx, client, propertyID, version, network)
+	versionStatus, err := resolveVersionStatus(ctx, client, propertyID, activateVersion, network)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if propertyActivation == nil || versionStatus == papi.VersionStatusDeactivated {
-		notifySet, err := tf.GetSetValue("contact", d)
+		createActivationRequest, err := newActivationRequest(d, propertyID, network, activateVersion, note, rollback)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		var notify []string
-		for _, contact := range notifySet.List() {
-			notify = append(notify, cast.ToString(contact))
-		}
 
-		createActivationRequest := papi.CreateActivationRequest{
-			PropertyID: propertyID,
-			Activation: papi.Activation{
-				ActivationType:         papi.ActivationTypeActivate,
-				Network:                network,
-				PropertyVersion:        version,
-				NotifyEmails:           notify,
-				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
-				Note:                   note,
-			},
+		if err := schedule.WaitFor(ctx, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 
-		activationID, diagErr := createActivation(ctx, client, addPropertyComplianceRecord(complianceRecord, createActivationRequest))
+		activationID, diagErr := createActivation(ctx, client, createActivationRequest)
 		if diagErr != nil {
 			return diagErr
 		}
@@ -682,11 +748,16 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 	if diagErr != nil {
 		return diagErr
 	}
+	if err := waitForActivationCertificates(ctx, d, client, propertyID, activateVersion, network); err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
 
 	attrs := map[string]interface{}{
-		"status":        string(propertyActivation.Status),
-		"activation_id": propertyActivation.ActivationID,
-		"version":       version,
+		"status":           string(propertyActivation.Status),
+		"activation_id":    propertyActivation.ActivationID,
+		"version":          version,
+		"previous_version": previousVersion,
 	}
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -694,7 +765,30 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(propertyID + ":" + string(network))
 
-	return nil
+	// a failed rollback is not rolled back again
+	rollbackVersion := previousVersion
+	if rollback {
+		rollbackVersion = 0
+	}
+	return verifyActivation(ctx, d, client, propertyID, network, rollbackVersion)
+}
+
+// setActivationNextEligibleTime marks the next eligible time of a pending activation as known after apply
+// and fails the plan when the activation cannot start before the configured timeout
+func setActivationNextEligibleTime(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChanges("version", "network", "rollback") {
+		return nil
+	}
+
+	timeout := PropertyResourceTimeout
+	if v, ok := d.GetOk("timeouts.0.default"); ok {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return err
+		}
+		timeout = duration
+	}
+	return schedule.SetNextEligibleTime(d, timeout)
 }
 
 func resourcePropertyActivationImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -712,6 +806,7 @@ func resourcePropertyActivationImport(_ context.Context, d *schema.ResourceData,
 	attrs["property_id"] = parts[0]
 	attrs["network"] = parts[1]
 	attrs["auto_acknowledge_rule_warnings"] = false
+	attrs["lint_fail_on"] = lintFailOnNone
 
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return nil, err
@@ -729,6 +824,72 @@ func resourcePropertyActivationImport(_ context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// waitForActivationCertificates waits, when requested, until certificates of DEFAULT provisioned hostnames
+// of the activated property version are deployed on the network
+func waitForActivationCertificates(ctx context.Context, d *schema.ResourceData, client papi.PAPI, propertyID string,
+	version int, network papi.ActivationNetwork) error {
+	if !d.Get("wait_for_certificates").(bool) {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	property := papi.Property{PropertyID: propertyID}
+	for {
+		hostnames, err := fetchPropertyVersionHostnames(ctx, client, property, version)
+		if err != nil {
+			return err
+		}
+		pending := pendingDefaultCertificates(hostnames, network)
+		if len(pending) == 0 {
+			return nil
+		}
+		logger.Debugf("waiting for %d certificates to be deployed on %s", len(pending), network)
+
+		select {
+		case <-time.After(certificatePollInterval):
+		case <-ctx.Done():
+			var details []string
+			for _, h := range pending {
+				details = append(details, fmt.Sprintf("%s (CNAME %s to %s)", h.CnameFrom, h.CertStatus.ValidationCname.Hostname, h.CertStatus.ValidationCname.Target))
+			}
+			return fmt.Errorf("%w: certificates are not deployed on %s for hostnames: %s", ErrCertificatesNotDeployed, network, strings.Join(details, ", "))
+		}
+	}
+}
+
+// newActivationRequest returns a request activating the version on the network with the contacts, compliance record
+// and warnings acknowledgement configured in the resource. A production rollback without a configured compliance record
+// is recorded as a non-compliant change with the note as the reason.
+func newActivationRequest(d *schema.ResourceData, propertyID string, network papi.ActivationNetwork, version int,
+	note string, rollback bool) (papi.CreateActivationRequest, error) {
+	notifySet, err := tf.GetSetValue("contact", d)
+	if err != nil {
+		return papi.CreateActivationRequest{}, err
+	}
+	complianceRecord, err := tf.GetListValue("compliance_record", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return papi.CreateActivationRequest{}, err
+	}
+
+	request := addPropertyComplianceRecord(complianceRecord, papi.CreateActivationRequest{
+		PropertyID: propertyID,
+		Activation: papi.Activation{
+			ActivationType:         papi.ActivationTypeActivate,
+			Network:                network,
+			PropertyVersion:        version,
+			NotifyEmails:           tf.SetToStringSlice(notifySet),
+			AcknowledgeAllWarnings: d.Get("auto_acknowledge_rule_warnings").(bool),
+			Note:                   note,
+		},
+	})
+	if rollback && network == papi.ActivationNetworkProduction && request.Activation.ComplianceRecord == nil {
+		request.Activation.ComplianceRecord = &papi.ComplianceRecordOther{
+			OtherNoncomplianceReason: note,
+		}
+	}
+	return request, nil
+}
+
 func addPropertyComplianceRecord(complianceRecord []interface{}, activatePAPIRequest papi.CreateActivationRequest) papi.CreateActivationRequest {
 	if len(complianceRecord) == 0 {
 		return activatePAPIRequest
@@ -828,6 +989,28 @@ func checkRuleTreeErrorsAndWarnings(rules *papi.GetRuleTreeResponse, d *schema.R
 	return diags
 }
 
+// checkRulesLint runs akamai_property_rules_lint checks on the rules to activate and fails on findings
+// at least as severe as 'lint_fail_on', before the activation is sent to PAPI
+func checkRulesLint(rules *papi.GetRuleTreeResponse, d *schema.ResourceData, logger log.Interface) diag.Diagnostics {
+	failOn, err := tf.GetStringValue("lint_fail_on", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if failOn == "" || failOn == lintFailOnNone {
+		return nil
+	}
+	severities, err := lintSeverities("lint_policy", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := lintDiagnostics(lintRules(rules.Rules, severities), failOn)
+	if diags.HasError() {
+		logger.Errorf("Property rules have %d lint findings with '%s' severity or higher", len(diags), failOn)
+	}
+	return diags
+}
+
 func setErrorsAndWarnings(d *schema.ResourceData, errors, warnings string) error {
 	if err := d.Set("errors", errors); err != nil {
 		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
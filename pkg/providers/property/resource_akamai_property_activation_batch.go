@@ -0,0 +1,640 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/timeouts"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+)
+
+func resourcePropertyActivationBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyActivationBatchCreate,
+		ReadContext:   resourcePropertyActivationBatchRead,
+		UpdateContext: resourcePropertyActivationBatchUpdate,
+		DeleteContext: resourcePropertyActivationBatchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"activation": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The property versions to activate together",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							StateFunc:   addPrefixToState("prp_"),
+							Description: "The property's unique identifier",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The property version to activate",
+						},
+						"previous_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The property version which was active on the network before the batch was applied (zero when none)",
+						},
+						"activation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The activation's unique identifier",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The activation's status",
+						},
+					},
+				},
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          papi.ActivationNetworkStaging,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.ValidateNetwork,
+				Description:      "The network to activate on, either STAGING or PRODUCTION",
+			},
+			"contact": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The email addresses notified about the activations",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Assigns a log message to the activation requests",
+			},
+			"auto_acknowledge_rule_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Automatically acknowledge all rule warnings for activations to continue. Default is false",
+			},
+			"rollback_on_failure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reactivates the previously active versions when any of the activations fails. Default is true",
+			},
+			"compliance_record": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Provides an audit record when activating on a production network",
+				Elem:        complianceRecordSchema,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The overall status of the batch",
+			},
+			"timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enables to set timeout for processing",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: timeouts.ValidateDurationFormat,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type (
+	// batchActivationItem tracks a single property activation of the batch
+	batchActivationItem struct {
+		propertyID      string
+		version         int
+		previousVersion int
+		activation      *papi.Activation
+		// created is true when the activation was requested by this apply
+		created bool
+		// failure describes why the activation did not finish
+		failure string
+	}
+
+	// batchActivationSettings contains settings shared by all activations of the batch
+	batchActivationSettings struct {
+		network                 papi.ActivationNetwork
+		notify                  []string
+		note                    string
+		acknowledgeRuleWarnings bool
+		complianceRecord        []interface{}
+	}
+)
+
+const batchStatusActive = "ACTIVE"
+
+var (
+	// ErrBatchActivation is returned when any of the batch activations fails
+	ErrBatchActivation = errors.New("batch activation failed")
+)
+
+func resourcePropertyActivationBatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationBatchCreate")
+	logger.Debug("resourcePropertyActivationBatchCreate call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	return activatePropertyBatch(ctx, d, Client(meta))
+}
+
+func resourcePropertyActivationBatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationBatchUpdate")
+	logger.Debug("resourcePropertyActivationBatchUpdate call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	if !d.HasChange("activation") {
+		logger.Debug("activations were not changed, update with no API calls")
+		return nil
+	}
+
+	settings, err := getBatchActivationSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldActivations, newActivations := d.GetChange("activation")
+	removed, err := removedBatchActivationItems(oldActivations.([]interface{}), newActivations.([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// properties removed from the batch are deactivated before the remaining ones are activated,
+	// so that a failed deactivation leaves the state untouched
+	if diags := deactivatePropertyBatch(ctx, Client(meta), removed, settings, logger); diags.HasError() {
+		d.Partial(true)
+		return diags
+	}
+
+	return activatePropertyBatch(ctx, d, Client(meta))
+}
+
+func resourcePropertyActivationBatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationBatchRead")
+	client := Client(meta)
+	logger.Debug("resourcePropertyActivationBatchRead call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	settings, err := getBatchActivationSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	items, err := getBatchActivationItems(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, item := range items {
+		resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{PropertyID: item.propertyID})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get activations for property %s: %w", item.propertyID, err))
+		}
+		activation, err := findLatestActive(resp.Activations.Items, settings.network)
+		if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+			return diag.Errorf("unexpected error searching for latest activation: %s", err)
+		}
+		if activation == nil {
+			// the version is no longer active, setting version to zero makes the next plan activate it again
+			item.version = 0
+			item.activation = nil
+			continue
+		}
+		item.version = activation.PropertyVersion
+		item.activation = activation
+	}
+
+	if err := setBatchActivationItems(d, items); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePropertyActivationBatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationBatchDelete")
+	client := Client(meta)
+	logger.Debug("resourcePropertyActivationBatchDelete call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	settings, err := getBatchActivationSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	items, err := getBatchActivationItems(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := deactivatePropertyBatch(ctx, client, items, settings, logger); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// deactivatePropertyBatch deactivates the versions of the items which are still active on the network
+func deactivatePropertyBatch(ctx context.Context, client papi.PAPI, items []*batchActivationItem, settings batchActivationSettings, logger log.Interface) diag.Diagnostics {
+	var toDeactivate []*batchActivationItem
+	for _, item := range items {
+		resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{PropertyID: item.propertyID})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get activations for property %s: %w", item.propertyID, err))
+		}
+		active, err := findLatestActive(resp.Activations.Items, settings.network)
+		if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+			return diag.Errorf("unexpected error searching for latest activation: %s", err)
+		}
+		if active == nil || active.PropertyVersion != item.version {
+			logger.Debugf("version %d of %s is not active, skipping deactivation", item.version, item.propertyID)
+			continue
+		}
+		activation, diags := requestBatchActivation(ctx, client, item.propertyID, item.version, papi.ActivationTypeDeactivate, settings)
+		if diags != nil {
+			return diags
+		}
+		item.activation = activation
+		toDeactivate = append(toDeactivate, item)
+	}
+
+	return pollBatchActivations(ctx, client, toDeactivate)
+}
+
+// activatePropertyBatch validates rule trees of all versions, activates them, polls them together
+// and rolls back the already activated versions when any of the activations fails
+func activatePropertyBatch(ctx context.Context, d *schema.ResourceData, client papi.PAPI) diag.Diagnostics {
+	settings, err := getBatchActivationSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	items, err := getBatchActivationItems(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rollback, err := tf.GetBoolValue("rollback_on_failure", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// nothing is activated unless every rule tree is valid
+	var diags diag.Diagnostics
+	for _, item := range items {
+		rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+			PropertyID:      item.propertyID,
+			PropertyVersion: item.version,
+			ValidateRules:   true,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(rules.Errors) > 0 {
+			diags = append(diags, diag.Errorf("property %s version %d has rule errors: %s",
+				item.propertyID, item.version, flattenErrorArray(rules.Errors))...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	started := make([]*batchActivationItem, 0, len(items))
+	for _, item := range items {
+		if diags = startBatchActivation(ctx, client, item, settings); diags != nil {
+			break
+		}
+		started = append(started, item)
+	}
+	// activations requested before a failure keep running, so they are polled either way
+	diags = append(diags, pollBatchActivations(ctx, client, started)...)
+	if diags.HasError() {
+		if rollback {
+			rollbackDiags := rollbackPropertyBatch(ctx, client, started, settings)
+			diags = append(diags, rollbackDiags...)
+			if !rollbackDiags.HasError() {
+				// previous versions are active again, so the state from before the apply is kept
+				d.Partial(true)
+				return diags
+			}
+		}
+		// versions which went live are recorded so that the next apply does not lose track of them
+		for _, item := range items {
+			if item.activation == nil || item.activation.Status != papi.ActivationStatusActive {
+				// the version is not active, setting version to zero makes the next plan activate it again
+				item.version = 0
+			}
+		}
+		return append(diags, setBatchActivationState(d, items, settings)...)
+	}
+
+	return setBatchActivationState(d, items, settings)
+}
+
+func setBatchActivationState(d *schema.ResourceData, items []*batchActivationItem, settings batchActivationSettings) diag.Diagnostics {
+	if err := setBatchActivationItems(d, items); err != nil {
+		return diag.FromErr(err)
+	}
+	propertyIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		propertyIDs = append(propertyIDs, item.propertyID)
+	}
+	sort.Strings(propertyIDs)
+	d.SetId(strings.Join(propertyIDs, ",") + ":" + string(settings.network))
+
+	return nil
+}
+
+// startBatchActivation requests activation of the item's version unless the version is already active or being activated.
+// The version active before is recorded as the item's previous version.
+func startBatchActivation(ctx context.Context, client papi.PAPI, item *batchActivationItem, settings batchActivationSettings) diag.Diagnostics {
+	resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{PropertyID: item.propertyID})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get activations for property %s: %w", item.propertyID, err))
+	}
+	active, err := findLatestActive(resp.Activations.Items, settings.network)
+	if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+		return diag.Errorf("unexpected error searching for latest activation: %s", err)
+	}
+	if active != nil && active.PropertyVersion == item.version {
+		item.activation = active
+		return nil
+	}
+	if active != nil {
+		item.previousVersion = active.PropertyVersion
+	}
+
+	pending, err := lookupActivation(ctx, client, lookupActivationRequest{
+		propertyID: item.propertyID,
+		version:    item.version,
+		network:    settings.network,
+		activationType: map[papi.ActivationType]struct{}{
+			papi.ActivationTypeActivate: {},
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pending != nil {
+		item.activation = pending
+		item.created = true
+		return nil
+	}
+
+	activation, diags := requestBatchActivation(ctx, client, item.propertyID, item.version, papi.ActivationTypeActivate, settings)
+	if diags != nil {
+		return diags
+	}
+	item.activation = activation
+	item.created = true
+	return nil
+}
+
+// rollbackPropertyBatch reactivates previously active versions of the properties activated by this batch.
+// Properties which had no active version before are deactivated.
+func rollbackPropertyBatch(ctx context.Context, client papi.PAPI, items []*batchActivationItem, settings batchActivationSettings) diag.Diagnostics {
+	if settings.note != "" {
+		settings.note = fmt.Sprintf("Rollback of batch activation: %s", settings.note)
+	} else {
+		settings.note = "Rollback of batch activation"
+	}
+
+	var toRollback []*batchActivationItem
+	for _, item := range items {
+		if !item.created || item.activation == nil || item.activation.Status != papi.ActivationStatusActive {
+			continue
+		}
+		var (
+			rollbackItem = &batchActivationItem{propertyID: item.propertyID}
+			activation   *papi.Activation
+			diags        diag.Diagnostics
+		)
+		if item.previousVersion > 0 {
+			rollbackItem.version = item.previousVersion
+			activation, diags = requestBatchActivation(ctx, client, item.propertyID, item.previousVersion, papi.ActivationTypeActivate, settings)
+		} else {
+			rollbackItem.version = item.version
+			activation, diags = requestBatchActivation(ctx, client, item.propertyID, item.version, papi.ActivationTypeDeactivate, settings)
+		}
+		if diags != nil {
+			return append(diags, diag.Errorf("rollback of property %s failed", item.propertyID)...)
+		}
+		rollbackItem.activation = activation
+		toRollback = append(toRollback, rollbackItem)
+	}
+	if len(toRollback) == 0 {
+		return nil
+	}
+
+	if diags := pollBatchActivations(ctx, client, toRollback); diags.HasError() {
+		return append(diags, diag.Errorf("rollback of batch activation failed")...)
+	}
+
+	rolledBack := make([]string, 0, len(toRollback))
+	for _, item := range toRollback {
+		rolledBack = append(rolledBack, item.propertyID)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Batch activation was rolled back",
+		Detail:   fmt.Sprintf("previous state was restored for properties: %s", strings.Join(rolledBack, ", ")),
+	}}
+}
+
+// requestBatchActivation creates an activation or deactivation and returns its initial state
+func requestBatchActivation(ctx context.Context, client papi.PAPI, propertyID string, version int,
+	activationType papi.ActivationType, settings batchActivationSettings) (*papi.Activation, diag.Diagnostics) {
+	request := papi.CreateActivationRequest{
+		PropertyID: propertyID,
+		Activation: papi.Activation{
+			ActivationType:         activationType,
+			Network:                settings.network,
+			PropertyVersion:        version,
+			NotifyEmails:           settings.notify,
+			AcknowledgeAllWarnings: settings.acknowledgeRuleWarnings,
+			Note:                   settings.note,
+		},
+	}
+
+	activationID, diags := createActivation(ctx, client, addPropertyComplianceRecord(settings.complianceRecord, request))
+	if diags != nil {
+		return nil, diags
+	}
+
+	act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+		ActivationID: activationID,
+		PropertyID:   propertyID,
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return act.Activation, nil
+}
+
+// pollBatchActivations polls all given activations concurrently until they are finished
+func pollBatchActivations(ctx context.Context, client papi.PAPI, items []*batchActivationItem) diag.Diagnostics {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		diags diag.Diagnostics
+	)
+	for _, item := range items {
+		wg.Add(1)
+		go func(item *batchActivationItem) {
+			defer wg.Done()
+			activation, pollDiags := pollActivation(ctx, client, item.activation, item.propertyID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if pollDiags.HasError() {
+				var failures []string
+				for _, d := range pollDiags {
+					failures = append(failures, d.Summary)
+					d.Summary = fmt.Sprintf("%s: property %s version %d: %s", ErrBatchActivation, item.propertyID, item.version, d.Summary)
+					diags = append(diags, d)
+				}
+				item.failure = strings.Join(failures, "; ")
+				return
+			}
+			item.activation = activation
+		}(item)
+	}
+	wg.Wait()
+
+	return diags
+}
+
+func getBatchActivationSettings(d *schema.ResourceData) (batchActivationSettings, error) {
+	network, err := networkAlias(d)
+	if err != nil {
+		return batchActivationSettings{}, err
+	}
+	contacts, err := tf.GetSetValue("contact", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return batchActivationSettings{}, err
+	}
+	var notify []string
+	for _, contact := range contacts.List() {
+		notify = append(notify, cast.ToString(contact))
+	}
+	note, err := tf.GetStringValue("note", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return batchActivationSettings{}, err
+	}
+	complianceRecord, err := tf.GetListValue("compliance_record", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return batchActivationSettings{}, err
+	}
+
+	return batchActivationSettings{
+		network:                 network,
+		notify:                  notify,
+		note:                    note,
+		acknowledgeRuleWarnings: d.Get("auto_acknowledge_rule_warnings").(bool),
+		complianceRecord:        complianceRecord,
+	}, nil
+}
+
+func getBatchActivationItems(d *schema.ResourceData) ([]*batchActivationItem, error) {
+	activations, err := tf.GetListValue("activation", d)
+	if err != nil {
+		return nil, err
+	}
+	return batchActivationItems(activations)
+}
+
+// removedBatchActivationItems returns the items of the old activation list whose properties are not in the new one
+func removedBatchActivationItems(oldActivations, newActivations []interface{}) ([]*batchActivationItem, error) {
+	oldItems, err := batchActivationItems(oldActivations)
+	if err != nil {
+		return nil, err
+	}
+	newItems, err := batchActivationItems(newActivations)
+	if err != nil {
+		return nil, err
+	}
+	kept := make(map[string]struct{}, len(newItems))
+	for _, item := range newItems {
+		kept[item.propertyID] = struct{}{}
+	}
+
+	var removed []*batchActivationItem
+	for _, item := range oldItems {
+		if _, ok := kept[item.propertyID]; !ok {
+			removed = append(removed, item)
+		}
+	}
+	return removed, nil
+}
+
+func batchActivationItems(activations []interface{}) ([]*batchActivationItem, error) {
+	items := make([]*batchActivationItem, 0, len(activations))
+	seen := make(map[string]struct{}, len(activations))
+	for _, a := range activations {
+		activation := a.(map[string]interface{})
+		item := &batchActivationItem{
+			propertyID:      str.AddPrefix(activation["property_id"].(string), "prp_"),
+			version:         activation["version"].(int),
+			previousVersion: activation["previous_version"].(int),
+		}
+		if _, ok := seen[item.propertyID]; ok {
+			return nil, fmt.Errorf("property %s is listed more than once", item.propertyID)
+		}
+		seen[item.propertyID] = struct{}{}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func setBatchActivationItems(d *schema.ResourceData, items []*batchActivationItem) error {
+	status := batchStatusActive
+	activations := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		var activationID, activationStatus string
+		if item.activation != nil {
+			activationID = item.activation.ActivationID
+			activationStatus = string(item.activation.Status)
+		}
+		if activationStatus != batchStatusActive {
+			status = activationStatus
+		}
+		activations = append(activations, map[string]interface{}{
+			"property_id":      item.propertyID,
+			"version":          item.version,
+			"previous_version": item.previousVersion,
+			"activation_id":    activationID,
+			"status":           activationStatus,
+		})
+	}
+
+	return tf.SetAttrs(d, map[string]interface{}{
+		"activation": activations,
+		"status":     status,
+	})
+}
@@ -0,0 +1,216 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResPropertyActivationBatch(t *testing.T) {
+	contact := []string{"user@example.com"}
+	activeVersion := func(version int, activationType papi.ActivationType) papi.GetActivationsResponse {
+		return generateActivationResponseMock(fmt.Sprintf("atv_%d", version), "batch note", version, activationType, "2020-10-28T15:04:05Z", contact)
+	}
+
+	tests := map[string]struct {
+		init  func(*papi.Mock)
+		steps []resource.TestStep
+	}{
+		"batch lifecycle": {
+			init: func(m *papi.Mock) {
+				// create
+				expectGetRuleTree(m, "prp_a", 2, ruleTreeResponseValid, nil).Once()
+				expectGetRuleTree(m, "prp_b", 3, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_a", activeVersion(1, papi.ActivationTypeActivate), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 2, "STAGING", contact, "batch note", "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "batch note", contact, nil).Once()
+				expectGetActivations(m, "prp_b", papi.GetActivationsResponse{}, nil).Twice()
+				expectCreateActivation(m, "prp_b", papi.ActivationTypeActivate, 3, "STAGING", contact, "batch note", "atv_b", false, nil).Once()
+				expectGetActivation(m, "prp_b", "atv_b", 3, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "batch note", contact, nil).Once()
+				// read
+				expectGetActivations(m, "prp_a", activeVersion(2, papi.ActivationTypeActivate), nil).Times(3)
+				expectGetActivations(m, "prp_b", activeVersion(3, papi.ActivationTypeActivate), nil).Times(3)
+				// delete
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeDeactivate, 2, "STAGING", contact, "batch note", "atv_a_deactivate", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a_deactivate", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeDeactivate, "batch note", contact, nil).Once()
+				expectCreateActivation(m, "prp_b", papi.ActivationTypeDeactivate, 3, "STAGING", contact, "batch note", "atv_b_deactivate", false, nil).Once()
+				expectGetActivation(m, "prp_b", "atv_b_deactivate", 3, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeDeactivate, "batch note", contact, nil).Once()
+			},
+			steps: []resource.TestStep{{
+				Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationBatch/create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "id", "prp_a,prp_b:STAGING"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "activation.0.property_id", "prp_a"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "activation.0.previous_version", "1"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "activation.1.property_id", "prp_b"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "activation.1.previous_version", "0"),
+					resource.TestCheckResourceAttr("akamai_property_activation_batch.batch", "activation.1.status", "ACTIVE"),
+				),
+			}},
+		},
+		"property listed twice": {
+			steps: []resource.TestStep{{
+				Config:      testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationBatch/duplicated.tf"),
+				ExpectError: regexp.MustCompile("property prp_a is listed more than once"),
+			}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			if test.init != nil {
+				test.init(client)
+			}
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps:                    test.steps,
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestActivatePropertyBatch(t *testing.T) {
+	contact := []string{"user@example.com"}
+	config := map[string]interface{}{
+		"contact":             []interface{}{"user@example.com"},
+		"rollback_on_failure": true,
+		"activation": []interface{}{
+			map[string]interface{}{"property_id": "prp_a", "version": 2},
+			map[string]interface{}{"property_id": "prp_b", "version": 3},
+		},
+	}
+	activeVersion := func(version int) papi.GetActivationsResponse {
+		return generateActivationResponseMock(fmt.Sprintf("atv_%d", version), "", version, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", contact)
+	}
+
+	tests := map[string]struct {
+		init             func(*papi.Mock)
+		rollbackDisabled bool
+		withError        *regexp.Regexp
+		withWarning      string
+		check            func(*testing.T, *schema.ResourceData)
+	}{
+		"failed activation is rolled back": {
+			init: func(m *papi.Mock) {
+				expectGetRuleTree(m, "prp_a", 2, ruleTreeResponseValid, nil).Once()
+				expectGetRuleTree(m, "prp_b", 3, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_a", activeVersion(1), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 2, "STAGING", contact, "", "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "", contact, nil).Once()
+				expectGetActivations(m, "prp_b", activeVersion(2), nil).Twice()
+				expectCreateActivation(m, "prp_b", papi.ActivationTypeActivate, 3, "STAGING", contact, "", "atv_b", false, nil).Once()
+				expectGetActivation(m, "prp_b", "atv_b", 3, "STAGING", papi.ActivationStatusFailed, papi.ActivationTypeActivate, "", contact, nil).Once()
+				// rollback of prp_a only, prp_b still has version 2 active
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 1, "STAGING", contact, "Rollback of batch activation", "atv_a_rollback", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a_rollback", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "Rollback of batch activation", contact, nil).Once()
+			},
+			withError:   regexp.MustCompile(`batch activation failed: property prp_b version 3: activation request failed in downstream system`),
+			withWarning: "Batch activation was rolled back",
+		},
+		"failed activation without rollback keeps the activated versions in state": {
+			init: func(m *papi.Mock) {
+				expectGetRuleTree(m, "prp_a", 2, ruleTreeResponseValid, nil).Once()
+				expectGetRuleTree(m, "prp_b", 3, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_a", activeVersion(1), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 2, "STAGING", contact, "", "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "", contact, nil).Once()
+				expectGetActivations(m, "prp_b", activeVersion(2), nil).Twice()
+				expectCreateActivation(m, "prp_b", papi.ActivationTypeActivate, 3, "STAGING", contact, "", "atv_b", false, nil).Once()
+				expectGetActivation(m, "prp_b", "atv_b", 3, "STAGING", papi.ActivationStatusFailed, papi.ActivationTypeActivate, "", contact, nil).Once()
+			},
+			rollbackDisabled: true,
+			withError:        regexp.MustCompile(`batch activation failed: property prp_b version 3`),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				assert.Equal(t, "prp_a,prp_b:STAGING", d.Id())
+				assert.Equal(t, 2, d.Get("activation.0.version"))
+				assert.Equal(t, "ACTIVE", d.Get("activation.0.status"))
+				assert.Equal(t, 0, d.Get("activation.1.version"))
+			},
+		},
+		"failed activation request rolls back the started activations": {
+			init: func(m *papi.Mock) {
+				expectGetRuleTree(m, "prp_a", 2, ruleTreeResponseValid, nil).Once()
+				expectGetRuleTree(m, "prp_b", 3, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_a", activeVersion(1), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 2, "STAGING", contact, "", "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "", contact, nil).Once()
+				expectGetActivations(m, "prp_b", activeVersion(2), nil).Twice()
+				expectCreateActivation(m, "prp_b", papi.ActivationTypeActivate, 3, "STAGING", contact, "", "", false,
+					&papi.Error{StatusCode: 400, Title: "Bad Request"}).Once()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 1, "STAGING", contact, "Rollback of batch activation", "atv_a_rollback", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a_rollback", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "Rollback of batch activation", contact, nil).Once()
+			},
+			withError:   regexp.MustCompile(`Bad Request`),
+			withWarning: "Batch activation was rolled back",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				assert.Empty(t, d.Id())
+			},
+		},
+		"invalid rule tree stops the batch": {
+			init: func(m *papi.Mock) {
+				expectGetRuleTree(m, "prp_a", 2, ruleTreeResponseValid, nil).Once()
+				expectGetRuleTree(m, "prp_b", 3, ruleTreeResponseInvalid, nil).Once()
+			},
+			withError: regexp.MustCompile(`property prp_b version 3 has rule errors`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+			config["rollback_on_failure"] = !test.rollbackDisabled
+			d := schema.TestResourceDataRaw(t, resourcePropertyActivationBatch().Schema, config)
+
+			diags := activatePropertyBatch(context.Background(), d, client)
+			require.True(t, diags.HasError())
+
+			var errs, warnings []string
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					errs = append(errs, d.Summary)
+				} else {
+					warnings = append(warnings, d.Summary)
+				}
+			}
+			assert.Regexp(t, test.withError, errs[0])
+			if test.withWarning != "" {
+				assert.Contains(t, warnings, test.withWarning)
+			}
+			if test.check != nil {
+				test.check(t, d)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemovedBatchActivationItems(t *testing.T) {
+	oldActivations := []interface{}{
+		map[string]interface{}{"property_id": "prp_a", "version": 2, "previous_version": 1},
+		map[string]interface{}{"property_id": "prp_b", "version": 3, "previous_version": 0},
+	}
+	newActivations := []interface{}{
+		map[string]interface{}{"property_id": "a", "version": 3, "previous_version": 0},
+	}
+
+	removed, err := removedBatchActivationItems(oldActivations, newActivations)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "prp_b", removed[0].propertyID)
+	assert.Equal(t, 3, removed[0].version)
+}
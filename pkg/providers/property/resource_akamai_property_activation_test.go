@@ -1,13 +1,17 @@
 package property
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -16,6 +20,90 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 		init  func(*papi.Mock)
 		steps []resource.TestStep
 	}{
+		"property activation rollback - OK": {
+			init: func(m *papi.Mock) {
+				// first step
+				// create
+				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T14:04:05Z", []string{"user@example.com"}), nil).Once()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
+					[]string{"user@example.com"}, "property activation note for updating", "atv_update", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_update", 2, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "property activation note for updating", []string{"user@example.com"}, nil).Once()
+				// read
+				expectGetActivations(m, "prp_test", activationsResponseSecondVersionIsActive, nil).Once()
+
+				// second step
+				// read
+				expectGetActivations(m, "prp_test", activationsResponseSecondVersionIsActive, nil).Once()
+				// update
+				expectGetRuleTree(m, "prp_test", 1, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", activationsResponseSecondVersionIsActive, nil).Once()
+				expectGetPropertyVersion(m, "prp_test", "", "", 1, papi.VersionStatusInactive, "").Once()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 1, "STAGING",
+					[]string{"user@example.com"}, "Rollback of prp_test on STAGING from version 2 to version 1", "atv_rollback", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_rollback", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "Rollback of prp_test on STAGING from version 2 to version 1", []string{"user@example.com"}, nil).Once()
+				// read
+				rolledBack := generateActivationResponseMock("atv_rollback", "Rollback of prp_test on STAGING from version 2 to version 1", 1, papi.ActivationTypeActivate, "2020-10-28T16:04:05Z", []string{"user@example.com"})
+				expectGetActivations(m, "prp_test", rolledBack, nil).Once()
+				// delete
+				expectGetActivations(m, "prp_test", rolledBack, nil).Once()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeDeactivate, 1, "STAGING",
+					[]string{"user@example.com"}, "Rollback of prp_test on STAGING from version 2 to version 1", "atv_deactivation", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_deactivation", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeDeactivate, "Rollback of prp_test on STAGING from version 2 to version 1", []string{"user@example.com"}, nil).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/rollback/resource_property_activation.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "version", "2"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "previous_version", "1"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_update"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/rollback/resource_property_activation_rollback.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "version", "2"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "previous_version", "1"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "rollback", "true"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_rollback"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
+					),
+				},
+			},
+		},
+		"failed verification keeps the activation - OK": {
+			init: func(m *papi.Mock) {
+				active := generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"})
+				// create
+				expectGetRuleTree(m, "prp_test", 1, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", papi.GetActivationsResponse{}, nil).Once()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 1, "STAGING",
+					[]string{"user@example.com"}, "property activation note for creating", "atv_activation1", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_activation1", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, "property activation note for creating", []string{"user@example.com"}, nil).Once()
+				// reads of both steps
+				expectGetActivations(m, "prp_test", active, nil)
+				// delete, the only deactivation
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeDeactivate, 1, "STAGING",
+					[]string{"user@example.com"}, "property activation note for creating", "atv_deactivation", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_deactivation", 1, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeDeactivate, "property activation note for creating", []string{"user@example.com"}, nil).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/verification/resource_property_activation.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "id", "prp_test:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "version", "1"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
+					),
+				},
+				{
+					// a tainted activation would be replaced, deactivating the property
+					Config:   testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/verification/resource_property_activation.tf"),
+					PlanOnly: true,
+				},
+			},
+		},
 		"property activation lifecycle - OK": {
 			init: func(m *papi.Mock) {
 				// first step
@@ -33,7 +121,7 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
 				// update
 				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
-				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
+				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Twice()
 				expectGetPropertyVersion(m, "prp_test", "", "", 2, papi.VersionStatusInactive, "").Once()
 				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
 					[]string{"user@example.com"}, "property activation note for updating", "atv_update", true, nil).Once()
@@ -77,6 +165,7 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "property_id", "prp_test"),
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "network", "STAGING"),
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "version", "2"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "previous_version", "1"),
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_update"),
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
 						resource.TestCheckResourceAttr("akamai_property_activation.test", "note", "property activation note for updating"),
@@ -438,6 +527,22 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 				},
 			},
 		},
+		"check schema property activation - window cannot be met before timeout": {
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/schedule/resource_property_activation_window_not_met.tf"),
+					ExpectError: regexp.MustCompile("activation window cannot be met: the activation may start at 2999-01-01T00:00:00Z"),
+				},
+			},
+		},
+		"check schema property activation - invalid window": {
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "./testdata/TestPropertyActivation/schedule/resource_property_activation_invalid_window.tf"),
+					ExpectError: regexp.MustCompile("expected a day of the week"),
+				},
+			},
+		},
 		"check schema property activation - incorrect timeout duration": {
 			steps: []resource.TestStep{
 				{
@@ -629,7 +734,7 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
 				// update - note field not suppressed update of contact field and version
 				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
-				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
+				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Twice()
 				expectGetPropertyVersion(m, "prp_test", "", "", 2, papi.VersionStatusInactive, "").Once()
 				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
 					[]string{"user@example.com", "user2@example.com"}, "property activation note for updating", "atv_update", true, nil).Once()
@@ -695,7 +800,7 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
 				// update
 				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
-				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
+				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Twice()
 				expectGetPropertyVersion(m, "prp_test", "", "", 2, papi.VersionStatusInactive, "").Once()
 				// error on update
 				m.On("CreateActivation", AnyCTX, papi.CreateActivationRequest{
@@ -852,7 +957,7 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 
 				// Retry after error resolution - Update
 				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
-				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Once()
+				expectGetActivations(m, "prp_test", generateActivationResponseMock("atv_activation1", "property activation note for creating", 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", []string{"user@example.com"}), nil).Twice()
 				expectGetPropertyVersion(m, "prp_test", "", "", 2, papi.VersionStatusInactive, "").Once()
 				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
 					[]string{"user@example.com", "user2@example.com"}, "property activation note for updating", "atv_update", true, nil).Once()
@@ -1146,3 +1251,103 @@ var (
 		return client.On("GetPropertyVersion", AnyCTX, req).Return(&res, nil)
 	}
 )
+
+func TestWaitForActivationCertificates(t *testing.T) {
+	origInterval := certificatePollInterval
+	certificatePollInterval = time.Millisecond
+	defer func() { certificatePollInterval = origInterval }()
+
+	hostnamesResponse := func(status string) *papi.GetPropertyVersionHostnamesResponse {
+		return &papi.GetPropertyVersionHostnamesResponse{
+			Hostnames: papi.HostnameResponseItems{Items: []papi.Hostname{
+				{
+					CnameFrom:            "a.example.com",
+					CnameTo:              "a.example.com.edgekey.net",
+					CertProvisioningType: "DEFAULT",
+					CertStatus: papi.CertStatusItem{
+						ValidationCname: papi.ValidationCname{Hostname: "_acme-challenge.a.example.com", Target: "a.example.com.acme-validate.edgekey.net"},
+						Staging:         []papi.StatusItem{{Status: "DEPLOYED"}},
+						Production:      []papi.StatusItem{{Status: status}},
+					},
+				},
+				{
+					CnameFrom:            "b.example.com",
+					CnameTo:              "b.example.com.edgekey.net",
+					CertProvisioningType: "CPS_MANAGED",
+				},
+			}},
+		}
+	}
+	// hostnames of the activated version are checked, not of the version active before
+	hostnamesRequest := papi.GetPropertyVersionHostnamesRequest{
+		PropertyID:        "prp_1",
+		PropertyVersion:   3,
+		IncludeCertStatus: true,
+	}
+	config := map[string]interface{}{
+		"property_id":           "prp_1",
+		"version":               3,
+		"contact":               []interface{}{"user@example.com"},
+		"wait_for_certificates": true,
+	}
+
+	tests := map[string]struct {
+		config    map[string]interface{}
+		network   papi.ActivationNetwork
+		init      func(*papi.Mock)
+		timeout   time.Duration
+		withError string
+	}{
+		"no wait requested": {
+			config:  map[string]interface{}{"property_id": "prp_1", "version": 3, "contact": []interface{}{"user@example.com"}},
+			network: papi.ActivationNetworkProduction,
+			init:    func(_ *papi.Mock) {},
+		},
+		"certificate deployed after polling": {
+			config:  config,
+			network: papi.ActivationNetworkProduction,
+			init: func(m *papi.Mock) {
+				m.On("GetPropertyVersionHostnames", mock.Anything, hostnamesRequest).Return(hostnamesResponse("PENDING"), nil).Twice()
+				m.On("GetPropertyVersionHostnames", mock.Anything, hostnamesRequest).Return(hostnamesResponse("DEPLOYED"), nil).Once()
+			},
+		},
+		"certificate already deployed on staging": {
+			config:  config,
+			network: papi.ActivationNetworkStaging,
+			init: func(m *papi.Mock) {
+				m.On("GetPropertyVersionHostnames", mock.Anything, hostnamesRequest).Return(hostnamesResponse("PENDING"), nil).Once()
+			},
+		},
+		"timeout reports validation record": {
+			config:  config,
+			network: papi.ActivationNetworkProduction,
+			init: func(m *papi.Mock) {
+				m.On("GetPropertyVersionHostnames", mock.Anything, hostnamesRequest).Return(hostnamesResponse("PENDING"), nil)
+			},
+			timeout:   20 * time.Millisecond,
+			withError: "waiting for certificates failed: certificates are not deployed on PRODUCTION for hostnames: a.example.com (CNAME _acme-challenge.a.example.com to a.example.com.acme-validate.edgekey.net)",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+			d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, test.config)
+
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			err := waitForActivationCertificates(ctx, d, client, "prp_1", 3, test.network)
+			if test.withError != "" {
+				assert.EqualError(t, err, test.withError)
+			} else {
+				assert.NoError(t, err)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
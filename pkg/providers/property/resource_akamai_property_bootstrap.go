@@ -137,7 +137,7 @@ func (r *BootstrapResource) Create(ctx context.Context, req resource.CreateReque
 	productID := str.AddPrefix(data.ProductID.ValueString(), "prd_")
 
 	client := Client(r.meta)
-	propertyID, err := createProperty(ctx, client, data.Name.ValueString(), groupID, contractID, productID, "")
+	propertyID, err := createProperty(ctx, client, data.Name.ValueString(), groupID, contractID, productID, "", nil)
 	if err != nil {
 		err = interpretCreatePropertyErrorFramework(ctx, err, client, groupID, contractID, productID)
 		if err != nil {
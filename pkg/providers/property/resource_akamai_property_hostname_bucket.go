@@ -0,0 +1,482 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/timeouts"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+)
+
+func resourcePropertyHostnameBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyHostnameBucketCreate,
+		ReadContext:   resourcePropertyHostnameBucketRead,
+		UpdateContext: resourcePropertyHostnameBucketUpdate,
+		DeleteContext: resourcePropertyHostnameBucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePropertyHostnameBucketImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"property_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("prp_"),
+				Description: "The property's unique identifier",
+			},
+			"contract_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("ctr_"),
+				Description: "The contract under which the property is created",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("grp_"),
+				Description: "The group under which the property is created",
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          papi.ActivationNetworkStaging,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.ValidateNetwork,
+				Description:      "The network on which the hostnames are served, either STAGING or PRODUCTION",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Assigns a log message to the hostname activations",
+			},
+			"notify_emails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The email addresses notified about the hostname activations",
+			},
+			"wait_for_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Waits until certificates of the DEFAULT provisioned hostnames are deployed on the network. Default is true",
+			},
+			"hostnames": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         hashHostname,
+				Description: "The hostnames served by the property on the network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cname_from": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: tf.IsNotBlank,
+							Description:      "The hostname that your end users see",
+						},
+						"cname_to": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: tf.IsNotBlank,
+							Description:      "The edge hostname you point the property hostname to",
+						},
+						"cert_provisioning_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: tf.ValidateStringInSlice([]string{"CPS_MANAGED", "DEFAULT"}),
+							Description:      "The certificate's provisioning type, either CPS_MANAGED or DEFAULT",
+						},
+						"cname_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"edge_hostname_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_status": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     certStatus,
+						},
+					},
+				},
+			},
+			"activation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the last hostname activation",
+			},
+			"timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enables to set timeout for processing",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: timeouts.ValidateDurationFormat,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	// certStatusDeployed is the certificate status of a hostname able to serve secure traffic
+	certStatusDeployed = "DEPLOYED"
+
+	certProvisioningTypeDefault = "DEFAULT"
+)
+
+var (
+	// hostnameBucketPollInterval is the interval for polling hostname activations
+	hostnameBucketPollInterval = time.Minute
+
+	// certificatePollInterval is the interval for polling certificate statuses of DEFAULT provisioned hostnames
+	certificatePollInterval = time.Minute
+
+	// ErrHostnameActivation is returned when a hostname bucket activation does not succeed
+	ErrHostnameActivation = errors.New("hostname activation failed")
+)
+
+func resourcePropertyHostnameBucketCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketCreate")
+	logger.Debug("resourcePropertyHostnameBucketCreate call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	hostnames, err := tf.GetSetValue("hostnames", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	add, _ := diffBucketHostnames(nil, mapToHostnames(hostnames.List()))
+	if diags := patchHostnameBucket(ctx, d, hostnameBucketClientFor(meta), add, nil); diags != nil {
+		return diags
+	}
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", str.AddPrefix(d.Get("property_id").(string), "prp_"), network))
+
+	return resourcePropertyHostnameBucketRead(ctx, d, m)
+}
+
+func resourcePropertyHostnameBucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketRead")
+	logger.Debug("resourcePropertyHostnameBucketRead call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hostnames, err := listBucketHostnames(ctx, d, hostnameBucketClientFor(meta), network)
+	if err != nil {
+		var apiErr *papi.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			logger.Warnf("property %s not found, removing hostname bucket from state", d.Get("property_id"))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("hostnames", flattenHostnames(hostnames)); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
+	return nil
+}
+
+func resourcePropertyHostnameBucketUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketUpdate")
+	logger.Debug("resourcePropertyHostnameBucketUpdate call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	if !d.HasChange("hostnames") {
+		logger.Debug("hostnames were not changed, update with no API calls")
+		return nil
+	}
+
+	o, n := d.GetChange("hostnames")
+	add, remove := diffBucketHostnames(mapToHostnames(o.(*schema.Set).List()), mapToHostnames(n.(*schema.Set).List()))
+	logger.Debugf("adding %d and removing %d hostnames", len(add), len(remove))
+
+	if diags := patchHostnameBucket(ctx, d, hostnameBucketClientFor(meta), add, remove); diags != nil {
+		return diags
+	}
+
+	return resourcePropertyHostnameBucketRead(ctx, d, m)
+}
+
+func resourcePropertyHostnameBucketDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketDelete")
+	logger.Debug("resourcePropertyHostnameBucketDelete call")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+
+	hostnames, err := tf.GetSetValue("hostnames", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, remove := diffBucketHostnames(mapToHostnames(hostnames.List()), nil)
+
+	if diags := patchHostnameBucket(ctx, d, hostnameBucketClientFor(meta), nil, remove); diags != nil {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePropertyHostnameBucketImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 && len(parts) != 4 {
+		return nil, fmt.Errorf("invalid hostname bucket identifier: %s, expected 'property_id:network' or 'property_id:contract_id:group_id:network'", d.Id())
+	}
+
+	attrs := map[string]interface{}{
+		"property_id":           str.AddPrefix(parts[0], "prp_"),
+		"network":               parts[len(parts)-1],
+		"wait_for_certificates": true,
+	}
+	if len(parts) == 4 {
+		attrs["contract_id"] = str.AddPrefix(parts[1], "ctr_")
+		attrs["group_id"] = str.AddPrefix(parts[2], "grp_")
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s:%s", attrs["property_id"], parts[len(parts)-1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// patchHostnameBucket applies the hostname changes, waits for the hostname activation
+// and, if requested, for the certificates of added DEFAULT hostnames
+func patchHostnameBucket(ctx context.Context, d *schema.ResourceData, client hostnameBucket, add []papi.Hostname, remove []string) diag.Diagnostics {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	note, err := tf.GetStringValue("note", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	emails, err := tf.GetListValue("notify_emails", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	var notifyEmails []string
+	for _, email := range emails {
+		notifyEmails = append(notifyEmails, cast.ToString(email))
+	}
+
+	propertyID := str.AddPrefix(d.Get("property_id").(string), "prp_")
+	resp, err := client.PatchPropertyHostnames(ctx, patchPropertyHostnamesRequest{
+		PropertyID:   propertyID,
+		ContractID:   bucketContractID(d),
+		GroupID:      bucketGroupID(d),
+		Network:      network,
+		Note:         note,
+		NotifyEmails: notifyEmails,
+		Add:          add,
+		Remove:       remove,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("activation_id", resp.ActivationID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
+
+	if err := pollHostnameActivation(ctx, client, propertyID, resp.ActivationID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(add) == 0 || !d.Get("wait_for_certificates").(bool) {
+		return nil
+	}
+	awaited := make(map[string]struct{})
+	for _, hostname := range add {
+		if hostname.CertProvisioningType == certProvisioningTypeDefault {
+			awaited[strings.ToLower(hostname.CnameFrom)] = struct{}{}
+		}
+	}
+	if err := waitForBucketCertificates(ctx, d, client, network, awaited); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// pollHostnameActivation waits until the hostname activation reaches a final status
+func pollHostnameActivation(ctx context.Context, client hostnameBucket, propertyID, activationID string) error {
+	for {
+		activation, err := client.GetPropertyHostnameActivation(ctx, getPropertyHostnameActivationRequest{
+			PropertyID:   propertyID,
+			ActivationID: activationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch activation.Status {
+		case hostnameActivationStatusActive:
+			return nil
+		case hostnameActivationStatusFailed, hostnameActivationStatusAborted, hostnameActivationStatusCancelled:
+			return fmt.Errorf("%w: activation %s has status %s", ErrHostnameActivation, activationID, activation.Status)
+		}
+
+		select {
+		case <-time.After(hostnameBucketPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("%w: activation %s: %s", ErrHostnameActivation, activationID, ctx.Err())
+		}
+	}
+}
+
+// waitForBucketCertificates waits until certificates of all awaited hostnames are deployed on the network
+func waitForBucketCertificates(ctx context.Context, d *schema.ResourceData, client hostnameBucket, network papi.ActivationNetwork, awaited map[string]struct{}) error {
+	if len(awaited) == 0 {
+		return nil
+	}
+
+	for {
+		hostnames, err := listBucketHostnames(ctx, d, client, network)
+		if err != nil {
+			return err
+		}
+		var pending []string
+		for _, hostname := range pendingDefaultCertificates(hostnames, network) {
+			if _, ok := awaited[strings.ToLower(hostname.CnameFrom)]; ok {
+				pending = append(pending, hostname.CnameFrom)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(certificatePollInterval):
+		case <-ctx.Done():
+			sort.Strings(pending)
+			return fmt.Errorf("certificates of hostnames %s are not deployed on %s: %s", strings.Join(pending, ", "), network, ctx.Err())
+		}
+	}
+}
+
+// pendingDefaultCertificates returns DEFAULT provisioned hostnames whose certificate is not yet deployed on the network
+func pendingDefaultCertificates(hostnames []papi.Hostname, network papi.ActivationNetwork) []papi.Hostname {
+	var pending []papi.Hostname
+	for _, hostname := range hostnames {
+		if hostname.CertProvisioningType != certProvisioningTypeDefault {
+			continue
+		}
+		statuses := hostname.CertStatus.Staging
+		if network == papi.ActivationNetworkProduction {
+			statuses = hostname.CertStatus.Production
+		}
+		if len(statuses) == 0 || statuses[0].Status != certStatusDeployed {
+			pending = append(pending, hostname)
+		}
+	}
+	return pending
+}
+
+func listBucketHostnames(ctx context.Context, d *schema.ResourceData, client hostnameBucket, network papi.ActivationNetwork) ([]papi.Hostname, error) {
+	items, err := client.ListPropertyHostnames(ctx, listPropertyHostnamesRequest{
+		PropertyID:        str.AddPrefix(d.Get("property_id").(string), "prp_"),
+		ContractID:        bucketContractID(d),
+		GroupID:           bucketGroupID(d),
+		Network:           network,
+		IncludeCertStatus: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hostnames := make([]papi.Hostname, 0, len(items))
+	for _, item := range items {
+		hostnames = append(hostnames, item.toHostname(network))
+	}
+	return hostnames, nil
+}
+
+// diffBucketHostnames returns hostnames to be added to and names of hostnames to be removed from the bucket.
+// Hostnames which changed their edge hostname or certificate type are added again which replaces them.
+func diffBucketHostnames(oldHostnames, newHostnames []papi.Hostname) ([]papi.Hostname, []string) {
+	key := func(h papi.Hostname) string {
+		return strings.ToLower(h.CnameFrom)
+	}
+
+	oldByName := make(map[string]papi.Hostname, len(oldHostnames))
+	for _, h := range oldHostnames {
+		oldByName[key(h)] = h
+	}
+	newByName := make(map[string]struct{}, len(newHostnames))
+
+	var add []papi.Hostname
+	for _, h := range newHostnames {
+		newByName[key(h)] = struct{}{}
+		old, ok := oldByName[key(h)]
+		if !ok || old.CnameTo != h.CnameTo || old.CertProvisioningType != h.CertProvisioningType {
+			add = append(add, h)
+		}
+	}
+
+	var remove []string
+	for _, h := range oldHostnames {
+		if _, ok := newByName[key(h)]; !ok {
+			remove = append(remove, h.CnameFrom)
+		}
+	}
+
+	sort.Slice(add, func(i, j int) bool { return add[i].CnameFrom < add[j].CnameFrom })
+	sort.Strings(remove)
+	return add, remove
+}
+
+func bucketContractID(d *schema.ResourceData) string {
+	if contractID := d.Get("contract_id").(string); contractID != "" {
+		return str.AddPrefix(contractID, "ctr_")
+	}
+	return ""
+}
+
+func bucketGroupID(d *schema.ResourceData) string {
+	if groupID := d.Get("group_id").(string); groupID != "" {
+		return str.AddPrefix(groupID, "grp_")
+	}
+	return ""
+}
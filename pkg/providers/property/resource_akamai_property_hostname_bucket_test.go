@@ -0,0 +1,237 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResPropertyHostnameBucket(t *testing.T) {
+	hostnameA := papi.Hostname{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "a.example.com", CnameTo: "a.example.com.edgekey.net", CertProvisioningType: "DEFAULT"}
+	hostnameB := papi.Hostname{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "b.example.com", CnameTo: "b.example.com.edgekey.net", CertProvisioningType: "CPS_MANAGED"}
+	hostnameC := papi.Hostname{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "c.example.com", CnameTo: "c.example.com.edgekey.net", CertProvisioningType: "CPS_MANAGED"}
+	toBucket := func(certStatus string, hostnames ...papi.Hostname) []bucketHostname {
+		var items []bucketHostname
+		for _, h := range hostnames {
+			item := bucketHostname{
+				CnameFrom:       h.CnameFrom,
+				CnameType:       h.CnameType,
+				StagingCnameTo:  h.CnameTo,
+				StagingCertType: h.CertProvisioningType,
+			}
+			if h.CertProvisioningType == "DEFAULT" {
+				item.CertStatus = papi.CertStatusItem{
+					ValidationCname: papi.ValidationCname{Hostname: "_acme-challenge." + h.CnameFrom, Target: "ac.example.com"},
+					Staging:         []papi.StatusItem{{Status: certStatus}},
+				}
+			}
+			items = append(items, item)
+		}
+		return items
+	}
+	listRequest := listPropertyHostnamesRequest{
+		PropertyID:        "prp_1",
+		ContractID:        "ctr_1",
+		GroupID:           "grp_1",
+		Network:           papi.ActivationNetworkStaging,
+		IncludeCertStatus: true,
+	}
+	patchRequest := func(add []papi.Hostname, remove []string) patchPropertyHostnamesRequest {
+		return patchPropertyHostnamesRequest{
+			PropertyID: "prp_1",
+			ContractID: "ctr_1",
+			GroupID:    "grp_1",
+			Network:    papi.ActivationNetworkStaging,
+			Note:       "bucket note",
+			Add:        add,
+			Remove:     remove,
+		}
+	}
+	activationActive := func(m *mockHostnameBucket, activationID string) {
+		m.On("GetPropertyHostnameActivation", mock.Anything, getPropertyHostnameActivationRequest{PropertyID: "prp_1", ActivationID: activationID}).
+			Return(&hostnameActivation{HostnameActivationID: activationID, Status: "ACTIVE"}, nil).Once()
+	}
+
+	tests := map[string]struct {
+		init  func(*mockHostnameBucket)
+		steps []resource.TestStep
+	}{
+		"create, update and delete hostnames": {
+			init: func(m *mockHostnameBucket) {
+				// create
+				m.On("PatchPropertyHostnames", mock.Anything, patchRequest([]papi.Hostname{hostnameA, hostnameB}, nil)).
+					Return(&patchPropertyHostnamesResponse{ActivationID: "atv_1"}, nil).Once()
+				activationActive(m, "atv_1")
+				m.On("ListPropertyHostnames", mock.Anything, listRequest).Return(toBucket("PENDING", hostnameA, hostnameB), nil).Once()
+				m.On("ListPropertyHostnames", mock.Anything, listRequest).Return(toBucket("DEPLOYED", hostnameA, hostnameB), nil).Times(4)
+				// update
+				m.On("PatchPropertyHostnames", mock.Anything, patchRequest([]papi.Hostname{hostnameC}, []string{"b.example.com"})).
+					Return(&patchPropertyHostnamesResponse{ActivationID: "atv_2"}, nil).Once()
+				activationActive(m, "atv_2")
+				m.On("ListPropertyHostnames", mock.Anything, listRequest).Return(toBucket("DEPLOYED", hostnameA, hostnameC), nil)
+				// delete
+				m.On("PatchPropertyHostnames", mock.Anything, patchRequest(nil, []string{"a.example.com", "c.example.com"})).
+					Return(&patchPropertyHostnamesResponse{ActivationID: "atv_3"}, nil).Once()
+				activationActive(m, "atv_3")
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyHostnameBucket/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_hostname_bucket.bucket", "id", "prp_1:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_hostname_bucket.bucket", "activation_id", "atv_1"),
+						resource.TestCheckResourceAttr("akamai_property_hostname_bucket.bucket", "hostnames.#", "2"),
+						resource.TestCheckTypeSetElemNestedAttrs("akamai_property_hostname_bucket.bucket", "hostnames.*", map[string]string{
+							"cname_from":                   "a.example.com",
+							"cert_status.0.staging_status": "DEPLOYED",
+							"cert_status.0.hostname":       "_acme-challenge.a.example.com",
+							"cert_provisioning_type":       "DEFAULT",
+						}),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyHostnameBucket/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_hostname_bucket.bucket", "activation_id", "atv_2"),
+						resource.TestCheckTypeSetElemNestedAttrs("akamai_property_hostname_bucket.bucket", "hostnames.*", map[string]string{
+							"cname_from": "c.example.com",
+						}),
+					),
+				},
+			},
+		},
+		"property removed outside of terraform": {
+			init: func(m *mockHostnameBucket) {
+				// create
+				m.On("PatchPropertyHostnames", mock.Anything, patchRequest([]papi.Hostname{hostnameB}, nil)).
+					Return(&patchPropertyHostnamesResponse{ActivationID: "atv_1"}, nil).Once()
+				activationActive(m, "atv_1")
+				m.On("ListPropertyHostnames", mock.Anything, listRequest).Return(toBucket("", hostnameB), nil).Once()
+				// refresh
+				m.On("ListPropertyHostnames", mock.Anything, listRequest).
+					Return(nil, fmt.Errorf("%w: %w", ErrListPropertyHostnames, &papi.Error{StatusCode: http.StatusNotFound}))
+			},
+			steps: []resource.TestStep{
+				{
+					Config:             testutils.LoadFixtureString(t, "testdata/TestResPropertyHostnameBucket/single.tf"),
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			origInterval, origCertInterval := hostnameBucketPollInterval, certificatePollInterval
+			hostnameBucketPollInterval, certificatePollInterval = time.Millisecond, time.Millisecond
+			defer func() { hostnameBucketPollInterval, certificatePollInterval = origInterval, origCertInterval }()
+
+			client := &mockHostnameBucket{}
+			test.init(client)
+			useHostnameBucket(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					Steps:                    test.steps,
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPatchHostnameBucket(t *testing.T) {
+	origInterval, origCertInterval := hostnameBucketPollInterval, certificatePollInterval
+	hostnameBucketPollInterval, certificatePollInterval = time.Millisecond, time.Millisecond
+	defer func() { hostnameBucketPollInterval, certificatePollInterval = origInterval, origCertInterval }()
+
+	hostname := papi.Hostname{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "a.example.com", CnameTo: "a.example.com.edgekey.net", CertProvisioningType: "DEFAULT"}
+	bucket := func(status string) []bucketHostname {
+		return []bucketHostname{{
+			CnameFrom:          "a.example.com",
+			ProductionCnameTo:  "a.example.com.edgekey.net",
+			ProductionCertType: "DEFAULT",
+			CertStatus:         papi.CertStatusItem{Production: []papi.StatusItem{{Status: status}}},
+		}}
+	}
+
+	tests := map[string]struct {
+		init      func(*mockHostnameBucket)
+		withError *regexp.Regexp
+	}{
+		"waits for certificate deployment": {
+			init: func(m *mockHostnameBucket) {
+				m.On("GetPropertyHostnameActivation", mock.Anything, mock.Anything).Return(&hostnameActivation{Status: "PENDING"}, nil).Once()
+				m.On("GetPropertyHostnameActivation", mock.Anything, mock.Anything).Return(&hostnameActivation{Status: "ACTIVE"}, nil).Once()
+				m.On("ListPropertyHostnames", mock.Anything, mock.Anything).Return(bucket("PENDING"), nil).Twice()
+				m.On("ListPropertyHostnames", mock.Anything, mock.Anything).Return(bucket("DEPLOYED"), nil).Once()
+			},
+		},
+		"failed activation": {
+			init: func(m *mockHostnameBucket) {
+				m.On("GetPropertyHostnameActivation", mock.Anything, mock.Anything).Return(&hostnameActivation{Status: "FAILED"}, nil).Once()
+			},
+			withError: regexp.MustCompile("hostname activation failed: activation atv_1 has status FAILED"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockHostnameBucket{}
+			client.On("PatchPropertyHostnames", mock.Anything, patchPropertyHostnamesRequest{
+				PropertyID: "prp_1",
+				Network:    papi.ActivationNetworkProduction,
+				Add:        []papi.Hostname{hostname},
+			}).Return(&patchPropertyHostnamesResponse{ActivationID: "atv_1"}, nil).Once()
+			test.init(client)
+
+			d := schema.TestResourceDataRaw(t, resourcePropertyHostnameBucket().Schema, map[string]interface{}{
+				"property_id": "prp_1",
+				"network":     "PRODUCTION",
+			})
+			diags := patchHostnameBucket(context.Background(), d, client, []papi.Hostname{hostname}, nil)
+			if test.withError != nil {
+				require.True(t, diags.HasError())
+				assert.Regexp(t, test.withError, diags[0].Summary)
+			} else {
+				require.False(t, diags.HasError(), diags)
+				assert.Equal(t, "atv_1", d.Get("activation_id"))
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDiffBucketHostnames(t *testing.T) {
+	hostname := func(from, to, certType string) papi.Hostname {
+		return papi.Hostname{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: from, CnameTo: to, CertProvisioningType: certType}
+	}
+
+	add, remove := diffBucketHostnames(
+		[]papi.Hostname{
+			hostname("a.example.com", "a.edgekey.net", "DEFAULT"),
+			hostname("b.example.com", "b.edgekey.net", "DEFAULT"),
+			hostname("c.example.com", "c.edgekey.net", "DEFAULT"),
+		},
+		[]papi.Hostname{
+			hostname("d.example.com", "d.edgekey.net", "CPS_MANAGED"),
+			hostname("A.example.com", "a.edgekey.net", "DEFAULT"),
+			hostname("b.example.com", "other.edgekey.net", "DEFAULT"),
+		},
+	)
+	assert.Equal(t, []papi.Hostname{
+		hostname("b.example.com", "other.edgekey.net", "DEFAULT"),
+		hostname("d.example.com", "d.edgekey.net", "CPS_MANAGED"),
+	}, add)
+	assert.Equal(t, []string{"c.example.com"}, remove)
+}
@@ -77,6 +77,31 @@ func resourcePropertyInclude() *schema.Resource {
 				Description:  "Specifies the type of the include, either 'MICROSERVICES' or 'COMMON_SETTINGS'",
 				ValidateFunc: validation.StringInSlice([]string{string(papi.IncludeTypeMicroServices), string(papi.IncludeTypeCommonSettings)}, false),
 			},
+			"clone_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Creates the include as a copy of an existing include version",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: tf.IsNotBlank,
+							Description:      "The ID of the include to clone",
+						},
+						"version": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "The version of the include to clone",
+						},
+					},
+				},
+			},
 			"rules": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -212,13 +237,19 @@ func resourcePropertyIncludeCreate(ctx context.Context, rd *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	cloneFrom, err := getIncludeCloneFrom(rd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createIncludeResp, err := client.CreateInclude(ctx, papi.CreateIncludeRequest{
-		ContractID:  contractID,
-		GroupID:     groupID,
-		ProductID:   productID,
-		IncludeName: name,
-		IncludeType: papi.IncludeType(includeType),
-		RuleFormat:  ruleFormat,
+		ContractID:       contractID,
+		GroupID:          groupID,
+		ProductID:        productID,
+		IncludeName:      name,
+		IncludeType:      papi.IncludeType(includeType),
+		RuleFormat:       ruleFormat,
+		CloneIncludeFrom: cloneFrom,
 	})
 	if err != nil {
 		return diag.Errorf("%s create: %s", ErrPropertyInclude, err)
@@ -527,6 +558,30 @@ func updateRules(ctx context.Context, client papi.PAPI, rd *schema.ResourceData,
 	return nil
 }
 
+// getIncludeCloneFrom returns the include version configured in the clone_from block or nil when the block is not set
+func getIncludeCloneFrom(rd *schema.ResourceData) (*papi.CloneIncludeFrom, error) {
+	cloneFrom, err := tf.GetListValue("clone_from", rd)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(cloneFrom) == 0 || cloneFrom[0] == nil {
+		return nil, nil
+	}
+
+	cloneFromMap, ok := cloneFrom[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: clone_from, %q", tf.ErrInvalidType, "map[string]interface{}")
+	}
+
+	return &papi.CloneIncludeFrom{
+		IncludeID: str.AddPrefix(cloneFromMap["include_id"].(string), "inc_"),
+		Version:   cloneFromMap["version"].(int),
+	}, nil
+}
+
 // canIncludeBeDeleted returns error if there is any version active on
 // either staging or production network as it prevents the deletion
 func canIncludeBeDeleted(include papi.Include) diag.Diagnostics {
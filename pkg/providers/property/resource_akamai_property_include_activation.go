@@ -103,6 +103,56 @@ func resourcePropertyIncludeActivation() *schema.Resource {
 				Description: "Provides an audit record when activating on a production network",
 				Elem:        complianceRecordSchema,
 			},
+			"activate_parents": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Activates the latest versions of the include's parent properties on the same network once the include is active",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The parent properties to activate. All parents of the include are activated when not set",
+						},
+					},
+				},
+			},
+			"parent_activations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the parent property activations requested with `activate_parents`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parent property ID",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The activated property version",
+						},
+						"activation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the property activation",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the property activation",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason why the property was not activated",
+						},
+					},
+				},
+			},
 			"timeouts": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -166,7 +216,9 @@ func resourcePropertyIncludeActivationCreate(ctx context.Context, d *schema.Reso
 		return err
 	}
 
-	return resourcePropertyIncludeActivationRead(ctx, d, m)
+	diags := activateIncludeParents(ctx, d, client)
+
+	return append(diags, resourcePropertyIncludeActivationRead(ctx, d, m)...)
 }
 
 func resourcePropertyIncludeActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -242,7 +294,13 @@ func resourcePropertyIncludeActivationUpdate(ctx context.Context, d *schema.Reso
 	if err != nil {
 		return err
 	}
-	return resourcePropertyIncludeActivationRead(ctx, d, m)
+
+	var diags diag.Diagnostics
+	if d.HasChanges("version", "activate_parents") {
+		diags = activateIncludeParents(ctx, d, client)
+	}
+
+	return append(diags, resourcePropertyIncludeActivationRead(ctx, d, m)...)
 }
 
 func resourcePropertyIncludeActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -348,6 +406,162 @@ func resourcePropertyIncludeActivationUpsert(ctx context.Context, d *schema.Reso
 	return nil
 }
 
+// activateIncludeParents activates the latest versions of parent properties when the activate_parents block is set.
+// The outcome of every parent activation is stored in parent_activations, also when some of them fail.
+// Failures are reported as warnings, as the include is already active and must not be tainted.
+func activateIncludeParents(ctx context.Context, d *schema.ResourceData, client papi.PAPI) diag.Diagnostics {
+	activateParents, err := tf.GetListValue("activate_parents", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var propertyIDs []string
+	if parents, ok := activateParents[0].(map[string]interface{}); ok {
+		if ids, ok := parents["property_ids"].(*schema.Set); ok {
+			for _, id := range tf.SetToStringSlice(ids) {
+				propertyIDs = append(propertyIDs, str.AddPrefix(id, "prp_"))
+			}
+		}
+	}
+
+	activationData := propertyIncludeActivationData{}
+	if err := activationData.populateFromResource(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	items, diags := activateParentProperties(ctx, client, activationData, propertyIDs)
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+
+	parentActivations := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		parentActivation := map[string]interface{}{
+			"property_id": item.propertyID,
+			"version":     item.version,
+			"error":       item.failure,
+		}
+		if item.activation != nil {
+			parentActivation["activation_id"] = item.activation.ActivationID
+			parentActivation["status"] = string(item.activation.Status)
+		}
+		if item.failure != "" {
+			parentActivation["status"] = string(papi.ActivationStatusFailed)
+		}
+		parentActivations = append(parentActivations, parentActivation)
+	}
+	if err := d.Set("parent_activations", parentActivations); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))...)
+	}
+
+	return diags
+}
+
+// activateParentProperties activates the latest versions of the include's parent properties on the network of the include activation
+// and waits until the activations are finished. When propertyIDs is not empty, only these parents are activated.
+// A failure of one parent does not stop the others, instead it is recorded on the returned item.
+func activateParentProperties(ctx context.Context, client papi.PAPI, activationData propertyIncludeActivationData, propertyIDs []string) ([]*batchActivationItem, diag.Diagnostics) {
+	logger := logger.Get("activateParentProperties")
+
+	isActive, err := isLatestActiveExpectedActivated(ctx, client, activationData)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if !isActive {
+		return nil, diag.Errorf("%s: include %s version %d is not active on %s", ErrParentActivation,
+			activationData.includeID, activationData.version, activationData.network)
+	}
+
+	parents, err := client.ListIncludeParents(ctx, papi.ListIncludeParentsRequest{
+		ContractID: activationData.contractID,
+		GroupID:    activationData.groupID,
+		IncludeID:  activationData.includeID,
+	})
+	if err != nil {
+		return nil, diag.Errorf("%s: %s", ErrParentActivation, err)
+	}
+
+	selected := make(map[string]struct{}, len(propertyIDs))
+	for _, id := range propertyIDs {
+		selected[id] = struct{}{}
+	}
+
+	var items []*batchActivationItem
+	for _, parent := range parents.Properties.Items {
+		if len(propertyIDs) > 0 {
+			if _, ok := selected[parent.PropertyID]; !ok {
+				continue
+			}
+			delete(selected, parent.PropertyID)
+		}
+
+		property, err := client.GetProperty(ctx, papi.GetPropertyRequest{
+			ContractID: parent.ContractID,
+			GroupID:    parent.GroupID,
+			PropertyID: parent.PropertyID,
+		})
+		if err != nil {
+			return nil, diag.Errorf("%s: %s", ErrParentActivation, err)
+		}
+		items = append(items, &batchActivationItem{
+			propertyID: parent.PropertyID,
+			version:    property.Property.LatestVersion,
+		})
+	}
+	if len(selected) > 0 {
+		notParents := make([]string, 0, len(selected))
+		for id := range selected {
+			notParents = append(notParents, id)
+		}
+		sort.Strings(notParents)
+		return nil, diag.Errorf("%s: properties %s are not parents of include %s", ErrParentActivation,
+			strings.Join(notParents, ", "), activationData.includeID)
+	}
+
+	note := fmt.Sprintf("Activation of include %s version %d", activationData.includeID, activationData.version)
+	if activationData.note != "" {
+		note = fmt.Sprintf("%s: %s", note, activationData.note)
+	}
+	settings := batchActivationSettings{
+		network:                 papi.ActivationNetwork(activationData.network),
+		notify:                  activationData.notifyEmails,
+		note:                    note,
+		acknowledgeRuleWarnings: activationData.acknowledgement,
+		complianceRecord:        activationData.complianceRecord,
+	}
+
+	var toPoll []*batchActivationItem
+	for _, item := range items {
+		logger.Debugf("activating parent property %s version %d", item.propertyID, item.version)
+		if diags := startBatchActivation(ctx, client, item, settings); diags.HasError() {
+			item.failure = diags[0].Summary
+			continue
+		}
+		if item.created {
+			toPoll = append(toPoll, item)
+		}
+	}
+	pollBatchActivations(ctx, client, toPoll)
+
+	var failures []string
+	for _, item := range items {
+		if item.failure != "" {
+			failures = append(failures, fmt.Sprintf("%s version %d: %s", item.propertyID, item.version, item.failure))
+		}
+	}
+	if len(failures) > 0 {
+		return items, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s: %d of %d parent properties failed to activate", ErrParentActivation, len(failures), len(items)),
+			Detail:   strings.Join(failures, "\n"),
+		}}
+	}
+	return items, nil
+}
+
 type propertyIncludeActivationData struct {
 	includeID        string
 	contractID       string
@@ -1,6 +1,7 @@
 package property
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math/rand"
@@ -12,9 +13,12 @@ import (
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v9/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -1127,3 +1131,156 @@ func TestReadTimeoutFromEnvOrDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestActivateParentProperties(t *testing.T) {
+	contact := []string{"user@example.com"}
+	activationData := propertyIncludeActivationData{
+		includeID:    "inc_1",
+		contractID:   "ctr_1",
+		groupID:      "grp_1",
+		version:      3,
+		network:      "STAGING",
+		notifyEmails: contact,
+		note:         "release",
+	}
+	parentNote := "Activation of include inc_1 version 3: release"
+	activeVersion := func(version int) papi.GetActivationsResponse {
+		return generateActivationResponseMock(fmt.Sprintf("atv_%d", version), "", version, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", contact)
+	}
+	expectIncludeActive := func(m *papi.Mock, version int) {
+		m.On("ListIncludeActivations", mock.Anything, papi.ListIncludeActivationsRequest{ContractID: "ctr_1", GroupID: "grp_1", IncludeID: "inc_1"}).
+			Return(&papi.ListIncludeActivationsResponse{Activations: papi.IncludeActivationsRes{Items: []papi.IncludeActivation{{
+				IncludeID:      "inc_1",
+				IncludeVersion: version,
+				Network:        papi.ActivationNetworkStaging,
+				ActivationType: papi.ActivationTypeActivate,
+				Status:         papi.ActivationStatusActive,
+			}}}}, nil).Once()
+	}
+	expectParents := func(m *papi.Mock) {
+		var items []papi.ParentProperty
+		for _, id := range []string{"prp_a", "prp_b", "prp_c"} {
+			items = append(items, papi.ParentProperty{PropertyID: id, ContractID: "ctr_1", GroupID: "grp_1"})
+		}
+		m.On("ListIncludeParents", mock.Anything, papi.ListIncludeParentsRequest{ContractID: "ctr_1", GroupID: "grp_1", IncludeID: "inc_1"}).
+			Return(&papi.ListIncludeParentsResponse{Properties: papi.ParentPropertyItems{Items: items}}, nil).Once()
+	}
+	expectLatestVersion := func(m *papi.Mock, propertyID string, version int) {
+		m.On("GetProperty", mock.Anything, papi.GetPropertyRequest{ContractID: "ctr_1", GroupID: "grp_1", PropertyID: propertyID}).
+			Return(&papi.GetPropertyResponse{Property: &papi.Property{PropertyID: propertyID, LatestVersion: version}}, nil).Once()
+	}
+
+	tests := map[string]struct {
+		propertyIDs    []string
+		init           func(*papi.Mock)
+		expectedStatus map[string]string
+		withError      string
+	}{
+		"selected parents are activated": {
+			propertyIDs: []string{"prp_a", "prp_b"},
+			init: func(m *papi.Mock) {
+				expectIncludeActive(m, 3)
+				expectParents(m)
+				expectLatestVersion(m, "prp_a", 5)
+				expectLatestVersion(m, "prp_b", 2)
+				expectGetActivations(m, "prp_a", activeVersion(4), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 5, "STAGING", contact, parentNote, "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 5, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, parentNote, contact, nil).Once()
+				// latest version of prp_b is already active
+				expectGetActivations(m, "prp_b", activeVersion(2), nil).Once()
+			},
+			expectedStatus: map[string]string{"prp_a": "ACTIVE", "prp_b": "ACTIVE"},
+		},
+		"failure of one parent is reported": {
+			init: func(m *papi.Mock) {
+				expectIncludeActive(m, 3)
+				expectParents(m)
+				expectLatestVersion(m, "prp_a", 5)
+				expectLatestVersion(m, "prp_b", 2)
+				expectLatestVersion(m, "prp_c", 7)
+				expectGetActivations(m, "prp_a", activeVersion(4), nil).Twice()
+				expectCreateActivation(m, "prp_a", papi.ActivationTypeActivate, 5, "STAGING", contact, parentNote, "atv_a", false, nil).Once()
+				expectGetActivation(m, "prp_a", "atv_a", 5, "STAGING", papi.ActivationStatusFailed, papi.ActivationTypeActivate, parentNote, contact, nil).Once()
+				expectGetActivations(m, "prp_b", activeVersion(2), nil).Once()
+				expectGetActivations(m, "prp_c", activeVersion(6), nil).Twice()
+				expectCreateActivation(m, "prp_c", papi.ActivationTypeActivate, 7, "STAGING", contact, parentNote, "atv_c", false, nil).Once()
+				expectGetActivation(m, "prp_c", "atv_c", 7, "STAGING", papi.ActivationStatusActive, papi.ActivationTypeActivate, parentNote, contact, nil).Once()
+			},
+			expectedStatus: map[string]string{"prp_a": "FAILED", "prp_b": "ACTIVE", "prp_c": "ACTIVE"},
+			withError:      "parent property activation: 1 of 3 parent properties failed to activate",
+		},
+		"selected property is not a parent": {
+			propertyIDs: []string{"prp_a", "prp_x"},
+			init: func(m *papi.Mock) {
+				expectIncludeActive(m, 3)
+				expectParents(m)
+				expectLatestVersion(m, "prp_a", 5)
+			},
+			withError: "parent property activation: properties prp_x are not parents of include inc_1",
+		},
+		"include version is not active": {
+			init: func(m *papi.Mock) {
+				expectIncludeActive(m, 2)
+			},
+			withError: "parent property activation: include inc_1 version 3 is not active on STAGING",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+
+			items, diags := activateParentProperties(context.Background(), client, activationData, test.propertyIDs)
+			if test.withError != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.withError, diags[0].Summary)
+			} else {
+				require.False(t, diags.HasError(), diags)
+			}
+
+			status := make(map[string]string)
+			for _, item := range items {
+				status[item.propertyID] = string(papi.ActivationStatusFailed)
+				if item.failure == "" {
+					status[item.propertyID] = string(item.activation.Status)
+				}
+			}
+			if test.expectedStatus != nil {
+				assert.Equal(t, test.expectedStatus, status)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+
+	t.Run("failed parent is reported as a warning", func(t *testing.T) {
+		client := &papi.Mock{}
+		expectIncludeActive(client, 3)
+		expectParents(client)
+		expectLatestVersion(client, "prp_a", 5)
+		expectGetActivations(client, "prp_a", activeVersion(4), nil).Twice()
+		expectCreateActivation(client, "prp_a", papi.ActivationTypeActivate, 5, "STAGING", contact, parentNote, "atv_a", false, nil).Once()
+		expectGetActivation(client, "prp_a", "atv_a", 5, "STAGING", papi.ActivationStatusFailed, papi.ActivationTypeActivate, parentNote, contact, nil).Once()
+
+		d := schema.TestResourceDataRaw(t, resourcePropertyIncludeActivation().Schema, map[string]interface{}{
+			"include_id":       "inc_1",
+			"contract_id":      "ctr_1",
+			"group_id":         "grp_1",
+			"version":          3,
+			"network":          "STAGING",
+			"notify_emails":    []interface{}{"user@example.com"},
+			"note":             "release",
+			"activate_parents": []interface{}{map[string]interface{}{"property_ids": []interface{}{"prp_a"}}},
+		})
+		diags := activateIncludeParents(context.Background(), d, client)
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "parent property activation: 1 of 1 parent properties failed to activate", diags[0].Summary)
+		assert.Equal(t, "prp_a", d.Get("parent_activations.0.property_id"))
+		assert.Equal(t, 5, d.Get("parent_activations.0.version"))
+		assert.Equal(t, "FAILED", d.Get("parent_activations.0.status"))
+		assert.NotEmpty(t, d.Get("parent_activations.0.error"))
+		client.AssertExpectations(t)
+	})
+}
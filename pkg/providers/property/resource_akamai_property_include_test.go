@@ -38,6 +38,7 @@ func TestResourcePropertyInclude(t *testing.T) {
 		productionStatus  papi.VersionStatus
 		includeType       papi.IncludeType
 		rules             papi.RulesUpdate
+		cloneFrom         *papi.CloneIncludeFrom
 	}
 
 	workdir := "./testdata/TestResPropertyInclude"
@@ -183,12 +184,13 @@ func TestResourcePropertyInclude(t *testing.T) {
 		testData.latestVersion++
 
 		createIncludeCall := m.On("CreateInclude", mock.Anything, papi.CreateIncludeRequest{
-			GroupID:     testData.groupID,
-			ContractID:  testData.contractID,
-			ProductID:   testData.productID,
-			IncludeName: testData.includeName,
-			RuleFormat:  testData.ruleFormat,
-			IncludeType: testData.includeType,
+			GroupID:          testData.groupID,
+			ContractID:       testData.contractID,
+			ProductID:        testData.productID,
+			IncludeName:      testData.includeName,
+			RuleFormat:       testData.ruleFormat,
+			IncludeType:      testData.includeType,
+			CloneIncludeFrom: testData.cloneFrom,
 		}).Return(newCreateIncludeResp(testData), nil)
 
 		if len(testData.rulesPath) == 0 {
@@ -343,6 +345,34 @@ func TestResourcePropertyInclude(t *testing.T) {
 				},
 			},
 		},
+		"create include - cloned from another include": {
+			testData: testData{
+				assetID:     "aid_555",
+				groupID:     "grp_123",
+				productID:   "prd_test",
+				includeID:   includeID,
+				ruleFormat:  "v2022-06-28",
+				contractID:  "ctr_123",
+				includeName: "test_include",
+				includeType: papi.IncludeTypeMicroServices,
+				cloneFrom:   &papi.CloneIncludeFrom{IncludeID: "inc_987", Version: 3},
+			},
+			init: func(m *papi.Mock, testData *testData) {
+				expectCreate(m, testData).Once()
+				expectRead(m, testData).Times(2)
+				expectDelete(m, testData).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "%s/property_include_clone_from.tf", workdir),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_include.test", "latest_version", "1"),
+						resource.TestCheckResourceAttr("akamai_property_include.test", "clone_from.0.include_id", "inc_987"),
+						resource.TestCheckResourceAttr("akamai_property_include.test", "clone_from.0.version", "3"),
+					),
+				},
+			},
+		},
 		"create include - with rules": {
 			testData: testData{
 				assetID:     "aid_555",
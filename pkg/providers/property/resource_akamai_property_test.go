@@ -1,6 +1,7 @@
 package property
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,12 +19,15 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v6/pkg/common/testutils"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -788,6 +792,46 @@ func TestPropertyLifecycle(t *testing.T) {
 				CheckEqual("rules", `{"rules":{"behaviors":[{"name":"origin","options":{"cacheKeyHostname":"REQUEST_HOST_HEADER","compress":true,"enableTrueClientIp":true,"forwardHostHeader":"REQUEST_HOST_HEADER","hostname":"test.domain","httpPort":80,"httpsPort":443,"originCertificate":"","originSni":true,"originType":"CUSTOMER","ports":"","trueClientIpClientSetting":false,"trueClientIpHeader":"True-Client-IP","verificationMode":"PLATFORM_SETTINGS"}}],"children":[{"behaviors":[{"name":"baseDirectory","options":{"value":"/smth/"}}],"criteria":[{"name":"requestHeader","options":{"headerName":"Accept-Encoding","matchCaseSensitiveValue":true,"matchOperator":"IS_ONE_OF","matchWildcardName":false,"matchWildcardValue":false}}],"name":"change fwd path","options":{},"criteriaMustSatisfy":"all"},{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","mustRevalidate":false,"ttl":"1m"}}],"name":"caching","options":{},"criteriaMustSatisfy":"any"}],"comments":"The behaviors in the Default Rule apply to all requests for the property hostname(s) unless another rule overrides the Default Rule settings.","name":"default","options":{},"variables":[{"description":"","hidden":true,"name":"TEST_EMPTY_FIELDS","sensitive":false,"value":""},{"description":"","hidden":true,"name":"TEST_NIL_FIELD","sensitive":false,"value":""}]}}`).
 				Build(),
 		},
+		"Lifecycle: update variable block": {
+			init: func(t *testing.T, p *mockProperty) {
+				// set initial data
+				p.mockPropertyData = basicData
+				p.ruleTree = mockRuleTreeData{
+					rules: papi.Rules{
+						Name: "default",
+						Variables: []papi.RuleVariable{
+							{Name: "PMUSER_ORIGIN", Value: ptr.To("origin-a.example.com")},
+						},
+					},
+				}
+				// create
+				mockResourcePropertyFullCreate(p)
+				// read x2
+				mockResourcePropertyRead(p, 2)
+				// read x1 before update
+				mockResourcePropertyRead(p)
+				// update of the variable value alone updates the rule tree
+				p.mockGetPropertyVersion()
+				p.ruleTree.rules.Variables = []papi.RuleVariable{
+					{Name: "PMUSER_ORIGIN", Value: ptr.To("origin-b.example.com")},
+				}
+				p.mockUpdateRuleTree()
+				// read x2
+				mockResourcePropertyRead(p, 2)
+				// delete
+				p.mockRemoveProperty()
+			},
+			configDir: "variable block",
+			checksForCreate: defaultChecker.
+				CheckEqual("variable.#", "1").
+				CheckEqual("variable.0.name", "PMUSER_ORIGIN").
+				CheckEqual("variable.0.value", "origin-a.example.com").
+				Build(),
+			checksForUpdate: defaultChecker.
+				CheckEqual("variable.#", "1").
+				CheckEqual("variable.0.value", "origin-b.example.com").
+				Build(),
+		},
 		"Lifecycle: Verify staging_version and production_version known at plan": {
 			init: func(t *testing.T, p *mockProperty) {
 				// set initial data
@@ -2283,3 +2327,165 @@ func TestValidatePropertyName(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenCertificateChallenges(t *testing.T) {
+	challenges := flattenCertificateChallenges([]papi.Hostname{
+		{CnameFrom: "a.example.com", CertProvisioningType: "DEFAULT", CertStatus: papi.CertStatusItem{
+			ValidationCname: papi.ValidationCname{Hostname: "_acme-challenge.a.example.com", Target: "a.example.com.acme-validate.edgekey.net"},
+		}},
+		{CnameFrom: "b.example.com", CertProvisioningType: "CPS_MANAGED"},
+	})
+	assert.Equal(t, []map[string]interface{}{{
+		"cname_from":         "a.example.com",
+		"cname_hostname":     "_acme-challenge.a.example.com",
+		"cname_target":       "a.example.com.acme-validate.edgekey.net",
+		"http_redirect_from": "http://a.example.com/.well-known/acme-challenge/",
+		"http_redirect_to":   "http://dcv.akamai.com/.well-known/acme-challenge/",
+	}}, challenges)
+}
+
+func TestCreatePropertyCloneFrom(t *testing.T) {
+	tests := map[string]struct {
+		config            map[string]interface{}
+		expectedCloneFrom *papi.PropertyCloneFrom
+	}{
+		"blank property": {
+			config: map[string]interface{}{},
+		},
+		"clone with hostnames": {
+			config: map[string]interface{}{
+				"clone_from": []interface{}{map[string]interface{}{
+					"property_id":    "123",
+					"version":        4,
+					"copy_hostnames": true,
+				}},
+			},
+			expectedCloneFrom: &papi.PropertyCloneFrom{PropertyID: "prp_123", Version: 4, CopyHostnames: true},
+		},
+		"clone without hostnames": {
+			config: map[string]interface{}{
+				"clone_from": []interface{}{map[string]interface{}{
+					"property_id": "prp_123",
+					"version":     1,
+				}},
+			},
+			expectedCloneFrom: &papi.PropertyCloneFrom{PropertyID: "prp_123", Version: 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceProperty().Schema, test.config)
+			cloneFrom, err := getPropertyCloneFrom(d)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCloneFrom, cloneFrom)
+
+			client := &papi.Mock{}
+			client.On("CreateProperty", mock.Anything, papi.CreatePropertyRequest{
+				ContractID: "ctr_1",
+				GroupID:    "grp_1",
+				Property: papi.PropertyCreate{
+					ProductID:    "prd_1",
+					PropertyName: "test_property",
+					CloneFrom:    test.expectedCloneFrom,
+				},
+			}).Return(&papi.CreatePropertyResponse{PropertyID: "prp_2"}, nil).Once()
+
+			propertyID, err := createProperty(context.Background(), client, "test_property", "grp_1", "ctr_1", "prd_1", "", cloneFrom)
+			require.NoError(t, err)
+			assert.Equal(t, "prp_2", propertyID)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckOutOfBandChanges(t *testing.T) {
+	state := func(attrs map[string]string) *schema.ResourceData {
+		attributes := map[string]string{
+			"id":             "prp_1",
+			"group_id":       "grp_1",
+			"contract_id":    "ctr_1",
+			"latest_version": "2",
+		}
+		for k, v := range attrs {
+			attributes[k] = v
+		}
+		return resourceProperty().Data(&sdkterraform.InstanceState{ID: "prp_1", Attributes: attributes})
+	}
+	property := papi.Property{PropertyID: "prp_1", GroupID: "grp_1", ContractID: "ctr_1", LatestVersion: 2}
+	expectGetProperty := func(m *papi.Mock, latestVersion int) {
+		m.On("GetProperty", mock.Anything, papi.GetPropertyRequest{PropertyID: "prp_1", GroupID: "grp_1", ContractID: "ctr_1"}).
+			Return(&papi.GetPropertyResponse{Property: &papi.Property{PropertyID: "prp_1", LatestVersion: latestVersion}}, nil).Once()
+	}
+	expectGetRuleTree := func(m *papi.Mock, etag string) {
+		m.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{PropertyID: "prp_1", GroupID: "grp_1", ContractID: "ctr_1", PropertyVersion: 2}).
+			Return(&papi.GetRuleTreeResponse{Response: papi.Response{}, Etag: etag}, nil).Once()
+	}
+
+	tests := map[string]struct {
+		attrs        map[string]string
+		init         func(*papi.Mock)
+		expectedEtag string
+		withError    *regexp.Regexp
+	}{
+		"no changes": {
+			attrs: map[string]string{"rules_etag": "etag1"},
+			init: func(m *papi.Mock) {
+				expectGetProperty(m, 2)
+				expectGetRuleTree(m, "etag1")
+			},
+			expectedEtag: "etag1",
+		},
+		"new version created": {
+			attrs: map[string]string{"rules_etag": "etag1"},
+			init: func(m *papi.Mock) {
+				expectGetProperty(m, 3)
+			},
+			withError: regexp.MustCompile("changed outside of Terraform.+: version 3 was created while the latest known version is 2"),
+		},
+		"rules modified": {
+			attrs: map[string]string{"rules_etag": "etag1"},
+			init: func(m *papi.Mock) {
+				expectGetProperty(m, 2)
+				expectGetRuleTree(m, "etag2")
+			},
+			withError: regexp.MustCompile("changed outside of Terraform.+: rules of version 2 were modified"),
+		},
+		"overwrite out-of-band changes": {
+			attrs: map[string]string{"rules_etag": "etag1", "overwrite_out_of_band_changes": "true"},
+			init:  func(_ *papi.Mock) {},
+		},
+		"no etag in state": {
+			init: func(_ *papi.Mock) {},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+			etag, err := checkOutOfBandChanges(context.Background(), client, property, state(test.attrs))
+			if test.withError != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrOutOfBandChange))
+				assert.Regexp(t, test.withError, err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedEtag, etag)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatePropertyRulesPreconditionFailed(t *testing.T) {
+	client := &papi.Mock{}
+	client.On("UpdateRuleTree", mock.Anything, mock.Anything).
+		Return(nil, &papi.Error{StatusCode: http.StatusPreconditionFailed, Title: "Precondition Failed"}).Once()
+
+	property := papi.Property{PropertyID: "prp_1", GroupID: "grp_1", ContractID: "ctr_1", LatestVersion: 2}
+	err := updatePropertyRules(context.Background(), client, property, papi.RulesUpdate{}, "v2023-01-05", "etag1")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrOutOfBandChange))
+	client.AssertExpectations(t)
+}
@@ -0,0 +1,179 @@
+package ruleformats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/iancoleman/strcase"
+)
+
+type (
+	// Catalog describes behaviors and criteria available in a rule format.
+	Catalog struct {
+		Behaviors []CatalogItem
+		Criteria  []CatalogItem
+	}
+
+	// CatalogItem describes a single behavior or criterion.
+	CatalogItem struct {
+		Name              string
+		SchemaName        string
+		Description       string
+		AllowedInIncludes bool
+		Placement         Placement
+		Options           []CatalogOption
+	}
+
+	// Placement describes which rules of a rule tree a behavior or criterion can be placed in.
+	Placement struct {
+		AllowedInDefaultRule  bool
+		AllowedInChildRules   bool
+		RequiredInDefaultRule bool
+	}
+
+	// CatalogOption describes a single option of a behavior or criterion.
+	CatalogOption struct {
+		Name          string
+		SchemaName    string
+		Type          string
+		Description   string
+		AllowedValues []string
+		Pattern       string
+		MinValue      *int
+		MaxValue      *int
+	}
+
+	// optionConstraints are the values accepted by an option, as enforced by its schema validator
+	optionConstraints struct {
+		AllowedValues []string
+		Pattern       string
+		MinValue      *int
+		MaxValue      *int
+	}
+)
+
+//go:generate go run ./internal/gencatalog -out catalog_constraints.gen.go
+
+var (
+	// internalOptions are present in every behavior and criterion schema, but are not meant to be set by users
+	internalOptions = map[string]struct{}{"locked": {}, "uuid": {}, "template_uuid": {}}
+
+	// requiredInDefaultRule are behaviors PAPI rejects a property without in its default rule
+	requiredInDefaultRule = map[string]struct{}{"cpCode": {}, "origin": {}}
+
+	// defaultRuleOnly are behaviors which only take effect in the default rule of a property
+	defaultRuleOnly = map[string]struct{}{"subCustomer": {}}
+)
+
+// GetCatalog returns behaviors and criteria catalog for the given rule format in the "vYYYY-MM-DD" form.
+func GetCatalog(ruleFormat string) (*Catalog, error) {
+	key := "rules_" + strings.ReplaceAll(ruleFormat, "-", "_")
+	for _, rf := range schemasRegistry.rules {
+		if rf.version != key {
+			continue
+		}
+		constraints := catalogConstraints[rf.version]
+		return &Catalog{
+			Behaviors: catalogItems(rf.behaviorsSchemas, constraints, rf.nameMappings, "behaviors"),
+			Criteria:  catalogItems(rf.criteriaSchemas, constraints, rf.nameMappings, "criteria"),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrRuleFormatNotSupported, ruleFormat)
+}
+
+func catalogItems(schemas map[string]*schema.Schema, constraints map[string]optionConstraints, nameMappings map[string]string, kind string) []CatalogItem {
+	includesNote := "This behavior cannot be used in includes."
+	if kind == "criteria" {
+		includesNote = "This criterion cannot be used in includes."
+	}
+
+	items := make([]CatalogItem, 0, len(schemas))
+	for schemaName, s := range schemas {
+		item := CatalogItem{
+			Name:              jsonName(schemaName, nameMappings),
+			SchemaName:        schemaName,
+			Description:       s.Description,
+			AllowedInIncludes: !strings.Contains(s.Description, includesNote),
+		}
+		item.Placement = placement(item.Name, kind)
+		if res, ok := s.Elem.(*schema.Resource); ok {
+			for optionName, option := range res.Schema {
+				if _, ok := internalOptions[optionName]; ok {
+					continue
+				}
+				key := fmt.Sprintf("%s.%s.%s", kind, schemaName, optionName)
+				item.Options = append(item.Options, catalogOption(key, optionName, option, constraints, nameMappings))
+			}
+			sort.Slice(item.Options, func(i, j int) bool {
+				return item.Options[i].Name < item.Options[j].Name
+			})
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
+
+// placement returns the rules an item can be placed in. The default rule of a property cannot have criteria.
+func placement(name, kind string) Placement {
+	if kind == "criteria" {
+		return Placement{AllowedInChildRules: true}
+	}
+	_, required := requiredInDefaultRule[name]
+	_, defaultOnly := defaultRuleOnly[name]
+	return Placement{
+		AllowedInDefaultRule:  true,
+		AllowedInChildRules:   !defaultOnly,
+		RequiredInDefaultRule: required,
+	}
+}
+
+func catalogOption(key, schemaName string, s *schema.Schema, constraints map[string]optionConstraints, nameMappings map[string]string) CatalogOption {
+	c := constraints[key]
+	return CatalogOption{
+		Name:          jsonName(schemaName, nameMappings),
+		SchemaName:    schemaName,
+		Type:          optionType(s),
+		Description:   s.Description,
+		AllowedValues: c.AllowedValues,
+		Pattern:       c.Pattern,
+		MinValue:      c.MinValue,
+		MaxValue:      c.MaxValue,
+	}
+}
+
+func optionType(s *schema.Schema) string {
+	switch s.Type {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList, schema.TypeSet:
+		if _, ok := s.Elem.(*schema.Resource); ok && s.MaxItems == 1 {
+			return "object"
+		}
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+func jsonName(schemaName string, nameMappings map[string]string) string {
+	name := strcase.ToLowerCamel(schemaName)
+	if mapped, ok := nameMappings[name]; ok {
+		return mapped
+	}
+	return name
+}
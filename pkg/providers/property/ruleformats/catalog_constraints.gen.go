@@ -0,0 +1,5152 @@
+// Code generated by gencatalog; DO NOT EDIT.
+
+package ruleformats
+
+import "github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr"
+
+// catalogConstraints maps rule format versions to constraints of behavior and criterion options.
+// Keys of the inner maps are in the "behaviors.<name>.<option>" or "criteria.<name>.<option>" form.
+var catalogConstraints = map[string]map[string]optionConstraints{
+	"rules_v2023_01_05": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.input_validation.failure302_uri":                                    {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.input_validation.label":                                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.input_validation.penalty302_uri":                                    {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.input_validation.penalty403_net_storage_path":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.input_validation.penalty_action":                                    {AllowedValues: []string{"REDIRECT_302", "BLANK_403", "BRANDED_403"}},
+		"behaviors.input_validation.penalty_branded_deny_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.input_validation.user_identification_key_cookie":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.input_validation.validate_on_origin_header_name":                    {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.input_validation.validate_on_origin_header_value":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.input_validation.validate_on_origin_response_code":                  {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.input_validation.validate_on_origin_with":                           {AllowedValues: []string{"DISABLED", "RESPONSE_CODE", "RESPONSE_CODE_AND_HEADER"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2023_05_30": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2023_09_20": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2023_10_30": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2024_01_09": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2024_02_12": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2024_05_31": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2024_08_13": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.first_byte_timeout":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+	"rules_v2024_10_21": {
+		"behaviors.ad_scaler_circuit_breaker.fallback_action_response_code_based":      {AllowedValues: []string{"RETURN_AKAMAI_COPY", "RETURN_ERROR"}},
+		"behaviors.ad_scaler_circuit_breaker.response_codes":                           {Pattern: "^(([0-9]{3})(,?))+$|{{.+}}"},
+		"behaviors.ad_scaler_circuit_breaker.response_delay_threshold":                 {AllowedValues: []string{"500ms"}},
+		"behaviors.ad_scaler_circuit_breaker.return_error_response_code_based":         {AllowedValues: []string{"SAME_AS_RECEIVED", "408", "500", "502", "504", "SPECIFY_YOUR_OWN"}},
+		"behaviors.ad_scaler_circuit_breaker.specify_your_own_response_code_based":     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.adaptive_acceleration.ab_logic":                                     {AllowedValues: []string{"DISABLED", "CLOUDLETS", "MANUAL"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier1_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier2_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_method":         {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_mobile_compression_value":          {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_method":       {AllowedValues: []string{"COMPRESS", "BYPASS", "STRIP"}},
+		"behaviors.adaptive_image_compression.tier3_standard_compression_value":        {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.aggregated_reporting.attributes_count":                              {MinValue: ptr.To(1), MaxValue: ptr.To(4)},
+		"behaviors.akamaizer_tag.scope":                                                {AllowedValues: []string{"ATTRIBUTE", "URL_ATTRIBUTE", "BLOCK", "PAGE"}},
+		"behaviors.akamaizer_tag.tags_attribute":                                       {AllowedValues: []string{"A", "A_HREF", "IMG", "IMG_SRC", "SCRIPT", "SCRIPT_SRC", "LINK", "LINK_HREF", "TD", "TD_BACKGROUND", "TABLE", "TABLE_BACKGROUND", "IFRAME", "IFRAME_SRC", "AREA", "AREA_HREF", "BASE", "BASE_HREF", "FORM", "FORM_ACTION"}},
+		"behaviors.allow_cloudlets_origins.purge_origin_query_parameter":               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.api_prioritization.alternate_response_cache_ttl":                    {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.api_prioritization.label":                                           {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.api_prioritization.net_storage_path":                                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.api_prioritization.throttled_status_code":                           {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_net_storage_file":                {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.application_load_balancer.all_down_status_code":                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"behaviors.application_load_balancer.failover_mode":                            {AllowedValues: []string{"AUTOMATIC", "MANUAL", "DISABLED"}},
+		"behaviors.application_load_balancer.label":                                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.origin_cookie_name":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_domain":                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_salt":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_cookie_type":                   {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "ORIGIN_SESSION"}},
+		"behaviors.application_load_balancer.stickiness_duration":                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.application_load_balancer.stickiness_expiration_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.audience_segmentation.label":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_domain":                     {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_salt":                       {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.population_cookie_type":                       {AllowedValues: []string{"NEVER", "ON_BROWSER_CLOSE", "DURATION"}},
+		"behaviors.audience_segmentation.population_duration":                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_cookie_name":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_custom_header":               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.audience_segmentation.segment_tracking_method":                      {AllowedValues: []string{"IN_QUERY_PARAM", "IN_COOKIE_HEADER", "IN_CUSTOM_HEADER", "NONE"}},
+		"behaviors.audience_segmentation.segment_tracking_query_param":                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.base_directory.value":                                               {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.boss_beaconing.conditional_sampling_frequency":                      {AllowedValues: []string{"CONDITIONAL_SAMPLING_FREQ_0_0", "CONDITIONAL_SAMPLING_FREQ_0_1", "CONDITIONAL_SAMPLING_FREQ_0_2", "CONDITIONAL_SAMPLING_FREQ_0_3"}},
+		"behaviors.boss_beaconing.cpcodes":                                             {Pattern: "^[0-9 ]*$|{{.+}}"},
+		"behaviors.boss_beaconing.forward_type":                                        {AllowedValues: []string{"MIDGRESS", "ORIGIN", "MIDGRESS_ORIGIN"}},
+		"behaviors.boss_beaconing.request_type":                                        {AllowedValues: []string{"EDGE", "EDGE_MIDGRESS"}},
+		"behaviors.boss_beaconing.sampling_frequency":                                  {AllowedValues: []string{"SAMPLING_FREQ_0_0", "SAMPLING_FREQ_0_1"}},
+		"behaviors.cache_error.ttl":                                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.cache_id.rule":                                                      {AllowedValues: []string{"INCLUDE_QUERY_PARAMS", "INCLUDE_COOKIES", "INCLUDE_HEADERS", "EXCLUDE_QUERY_PARAMS", "INCLUDE_ALL_QUERY_PARAMS", "INCLUDE_VARIABLE", "INCLUDE_URL"}},
+		"behaviors.cache_key_query_params.behavior":                                    {AllowedValues: []string{"INCLUDE_ALL_PRESERVE_ORDER", "INCLUDE_ALL_ALPHABETIZE_ORDER", "IGNORE_ALL", "INCLUDE", "IGNORE"}},
+		"behaviors.cache_key_rewrite.purge_key":                                        {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.cache_post.use_body":                                                {AllowedValues: []string{"IGNORE", "MD5", "QUERY"}},
+		"behaviors.cache_tag.tag":                                                      {Pattern: "^[a-zA-Z0-9\\&\\'\\^\\-\\$\\!\\`\\#\\%\\.\\+\\~\\_\\|\\/]+$|{{.+}}"},
+		"behaviors.cache_tag_visible.behavior":                                         {AllowedValues: []string{"NEVER", "PRAGMA_HEADER", "ALWAYS"}},
+		"behaviors.caching.behavior":                                                   {AllowedValues: []string{"MAX_AGE", "NO_STORE", "BYPASS_CACHE", "CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES"}},
+		"behaviors.caching.default_ttl":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.caching.ttl":                                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.client_characteristics.country":                                     {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM", "OTHER", "UNKNOWN"}},
+		"behaviors.cloud_wrapper_advanced.custom_failover_map":                         {Pattern: "^[a-zA-Z][a-zA-Z0-9-]*$|{{.+}}"},
+		"behaviors.conditional_origin.origin_id":                                       {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"behaviors.construct_response.response_code":                                   {AllowedValues: []string{"200", "404", "401", "403", "405", "417", "500", "501", "502", "503", "504"}},
+		"behaviors.content_characteristics.catalog_size":                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.content_type":                               {AllowedValues: []string{"USER_GENERATED", "WEB_OBJECTS", "SOFTWARE", "IMAGES", "OTHER_OBJECTS", "UNKNOWN"}},
+		"behaviors.content_characteristics.object_size":                                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics.popularity_distribution":                    {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.catalog_size":                           {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.content_type":                           {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.popularity_distribution":                {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_amd.segment_duration_dash":                  {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hds":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_hls":                   {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_duration_smooth":                {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_dash":                      {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hds":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_hls":                       {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_amd.segment_size_smooth":                    {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_dd.catalog_size":                            {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.content_type":                            {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.object_size":                             {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_dd.popularity_distribution":                 {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.catalog_size":                {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.content_type":                {AllowedValues: []string{"VIDEO", "SOFTWARE", "SOFTWARE_PATCH", "GAME", "GAME_PATCH", "OTHER_DOWNLOADS", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.object_size":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_large_file.popularity_distribution":     {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.catalog_size":                      {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.content_type":                      {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.popularity_distribution":           {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_dash":             {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hds":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_hls":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_duration_smooth":           {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_dash":                 {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hds":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_hls":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_live.segment_size_smooth":               {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.catalog_size":                       {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.content_type":                       {AllowedValues: []string{"SD", "HD", "ULTRA_HD", "OTHER", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.popularity_distribution":            {AllowedValues: []string{"LONG_TAIL", "ALL_POPULAR", "UNKNOWN"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_dash":              {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hds":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_hls":               {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_duration_smooth":            {AllowedValues: []string{"SEGMENT_DURATION_2S", "SEGMENT_DURATION_4S", "SEGMENT_DURATION_6S", "SEGMENT_DURATION_8S", "SEGMENT_DURATION_10S"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_dash":                  {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hds":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_hls":                   {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_characteristics_wsd_vod.segment_size_smooth":                {AllowedValues: []string{"LESS_THAN_1MB", "ONE_MB_TO_TEN_MB", "TEN_MB_TO_100_MB", "GREATER_THAN_100MB", "UNKNOWN", "OTHER"}},
+		"behaviors.content_pre_position.source_type":                                   {AllowedValues: []string{"ORIGIN"}},
+		"behaviors.content_pre_position.targets":                                       {AllowedValues: []string{"CLOUDWRAPPER"}},
+		"behaviors.content_targeting_protection.geo_protection_mode":                   {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.ip_protection_mode":                    {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.content_targeting_protection.referrer_protection_mode":              {AllowedValues: []string{"ALLOW", "DENY"}},
+		"behaviors.cors_support.allow_headers":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.allow_origins":                                         {AllowedValues: []string{"ANY", "SPECIFIED"}},
+		"behaviors.cors_support.preflight_max_age":                                     {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.datastream.datastream_ids":                                          {Pattern: "^[0-9]+(-[0-9]+)*$|{{.+}}"},
+		"behaviors.datastream.stream_type":                                             {AllowedValues: []string{"BEACON", "LOG", "BEACON_AND_LOG"}},
+		"behaviors.dcp_auth_hmac_transformation.hash_conversion_algorithm":             {AllowedValues: []string{"SHA256", "MD5", "SHA384"}},
+		"behaviors.dcp_auth_regex_transformation.regex_pattern":                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_end":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_substring_transformation.substring_start":                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.dcp_auth_variable_extractor.certificate_field":                      {AllowedValues: []string{"SUBJECT_DN", "V3_SUBJECT_ALT_NAME", "SERIAL", "FINGERPRINT_DYN", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "V3_NETSCAPE_COMMENT"}},
+		"behaviors.dcp_auth_variable_extractor.dcp_mutual_auth_processing_variable_id": {AllowedValues: []string{"VAR_DCP_CLIENT_ID", "VAR_DCP_AUTH_GROUP"}},
+		"behaviors.dcp_dev_relations.hostname":                                         {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.deny_access.reason":                                                 {Pattern: "^[\\w-]+$|{{.+}}"},
+		"behaviors.dns_async_refresh.timeout":                                          {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.delay":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.dns_prefresh.timeout":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.downstream_cache.allow_behavior":                                    {AllowedValues: []string{"LESSER", "GREATER", "REMAINING_LIFETIME", "FROM_MAX_AGE", "FROM_VALUE", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.behavior":                                          {AllowedValues: []string{"ALLOW", "MUST_REVALIDATE", "BUST", "TUNNEL_ORIGIN", "NONE"}},
+		"behaviors.downstream_cache.send_headers":                                      {AllowedValues: []string{"CACHE_CONTROL_AND_EXPIRES", "CACHE_CONTROL", "EXPIRES", "PASS_ORIGIN"}},
+		"behaviors.downstream_cache.ttl":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.ecms_database.extract_location":                                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_database.regex_pattern":                                        {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_dataset.extract_location":                                      {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "REGEX"}},
+		"behaviors.ecms_dataset.regex_pattern":                                         {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.ecms_object_key.regex":                                              {Pattern: "^[^\\(\\)]*\\([^\\(\\)]+\\)[^\\(\\)]*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_lines":                                       {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_size":                                        {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.edge_connect.aggregate_time":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.edge_connect.api_connector":                                         {AllowedValues: []string{"DEFAULT", "SIEM_JSON", "BMC_APM"}},
+		"behaviors.edge_connect.destination_hostname":                                  {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_connect.destination_path":                                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.cookie_name":                        {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.hostname":                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_data_center.ip":                                 {Pattern: "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.cookie_name":                             {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_load_balancing_origin.hostname":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.cookie_name":                              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.domain":                                   {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.edge_origin_authorization.value":                                    {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.enhanced_debug.debug_key":                                           {Pattern: "^[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.best_practice_action":                      {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.best_practice_redirecturl":                 {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_anonymous_vpn_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_action":            {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_hosting_provider_redirecturl":       {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_action":                {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_public_proxy_redirecturl":           {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_action":           {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_residential_proxy_redirecturl":      {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_smart_dns_proxy_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_action":               {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_tor_exit_node_redirecturl":          {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_action":             {AllowedValues: []string{"ALLOW", "DENY", "REDIRECT"}},
+		"behaviors.enhanced_proxy_detection.detect_vpn_data_center_redirecturl":        {Pattern: "(http|https)://(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(/|/([\\w#!:.?+=&%@!\\-/]))?|{{.+}}"},
+		"behaviors.enhanced_proxy_detection.enable_configuration_mode":                 {AllowedValues: []string{"BEST_PRACTICE", "ADVANCED"}},
+		"behaviors.fail_action.action_type":                                            {AllowedValues: []string{"SERVE_STALE", "REDIRECT", "RECREATED_CO", "RECREATED_CEX", "RECREATED_NS", "DYNAMIC"}},
+		"behaviors.fail_action.cex_hostname":                                           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.cex_path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.content_hostname":                                       {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.content_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.dynamic_method":                                         {AllowedValues: []string{"SERVE_301", "SERVE_302", "SERVE_ALTERNATE"}},
+		"behaviors.fail_action.dynamic_path":                                           {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.net_storage_path":                                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.protocol":                                               {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.fail_action.redirect_hostname":                                      {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.fail_action.redirect_hostname_type":                                 {AllowedValues: []string{"ORIGINAL", "ALTERNATE"}},
+		"behaviors.fail_action.redirect_method":                                        {AllowedValues: []string{"302", "301"}},
+		"behaviors.fail_action.redirect_path":                                          {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cname_level":                                       {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.fail_action.saas_cookie":                                            {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.fail_action.saas_query_string":                                      {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.fail_action.saas_regex":                                             {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.fail_action.saas_replace":                                           {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.fail_action.saas_suffix":                                            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.fail_action.saas_type":                                              {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.fail_action.status_code":                                            {AllowedValues: []string{"200", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.first_party_marketing.java_script_insertion_rule":                   {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing.media_math_prefix":                            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.first_party_marketing_plus.java_script_insertion_rule":              {AllowedValues: []string{"NEVER", "POLICY", "ALWAYS"}},
+		"behaviors.first_party_marketing_plus.media_math_prefix":                       {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.g2oheader.data_header":                                              {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.g2oheader.encoding_version":                                         {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.g2oheader.nonce":                                                    {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.g2oheader.secret_key":                                               {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.g2oheader.signed_header":                                            {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.header_name":                                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.global_request_number.output_option":                                {AllowedValues: []string{"RESPONSE_HEADER", "REQUEST_HEADER", "BOTH_HEADERS", "ASSIGN_VARIABLE"}},
+		"behaviors.graphql_caching.post_request_processing_error_handling":             {AllowedValues: []string{"APPLY_CACHING_BEHAVIOR", "NO_STORE"}},
+		"behaviors.gzip_response.behavior":                                             {AllowedValues: []string{"ORIGIN_RESPONSE", "ALWAYS", "NEVER"}},
+		"behaviors.health_detection.retry_interval":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.hsaf_eip_binding.tier":                                              {AllowedValues: []string{"EDGE", "PARENT", "BOTH"}},
+		"behaviors.http_strict_transport_security.max_age":                             {AllowedValues: []string{"ZERO_MINS", "TEN_MINS", "ONE_DAY", "ONE_MONTH", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}},
+		"behaviors.http_strict_transport_security.redirect_status_code":                {AllowedValues: []string{"301", "302"}},
+		"behaviors.im_override.format":                                                 {AllowedValues: []string{"CHROME", "IE", "SAFARI", "GENERIC", "AVIF_WEBP_JPEG_PNG_GIF", "JP2_WEBP_JPEG_PNG_GIF", "WEBP_JPEG_PNG_GIF", "JPEG_PNG_GIF"}},
+		"behaviors.im_override.override":                                               {AllowedValues: []string{"POLICY", "POLICY_VARIABLE", "WIDTH", "FORMAT", "DPR", "EXCLUDE_QUERY"}},
+		"behaviors.im_override.policy":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,32}$|{{.+}}"},
+		"behaviors.im_override.typesel":                                                {AllowedValues: []string{"VALUE", "VARIABLE"}},
+		"behaviors.im_override.width":                                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.image_and_video_manager.image_set":                                  {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_and_video_manager.video_set":                                  {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager.policy_set":                                           {Pattern: "^[a-zA-Z0-9_-]+([^-].|[^v])$|{{.+}}"},
+		"behaviors.image_manager.policy_token":                                         {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager.super_cache_region":                                   {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.image_manager_video.policy_set":                                     {Pattern: "^[a-zA-Z0-9_-]+-v$|{{.+}}"},
+		"behaviors.image_manager_video.policy_token":                                   {Pattern: "^[a-zA-Z0-9_-]{1,64}$|{{.+}}"},
+		"behaviors.image_manager_video.super_cache_region":                             {AllowedValues: []string{"US", "ASIA", "AUSTRALIA", "EMEA", "JAPAN", "CHINA"}},
+		"behaviors.large_file_optimization.enable_partial_object_caching":              {AllowedValues: []string{"PARTIAL_OBJECT_CACHING", "NON_PARTIAL_OBJECT_CACHING"}},
+		"behaviors.large_file_optimization.maximum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization.minimum_size":                               {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.large_file_optimization_advanced.fragment_size":                     {AllowedValues: []string{"HALF_MB", "ONE_MB", "TWO_MB", "FOUR_MB"}},
+		"behaviors.large_file_optimization_advanced.object_size":                       {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.m_pulse.api_key":                                                    {Pattern: "^$|^[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}-[a-zA-Z2-9]{5}$|{{.+}}"},
+		"behaviors.m_pulse.buffer_size":                                                {Pattern: "^(1[5-9][0-9]|1[0-9]{3}|[2-9][0-9]{2,3})$|{{.+}}"},
+		"behaviors.m_pulse.loader_version":                                             {AllowedValues: []string{"V10", "V12", "LATEST", "BETA"}},
+		"behaviors.manifest_personalization.hls_filter_in_bitrates":                    {Pattern: "^\\d+(,\\d+)*$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_mode":                                  {AllowedValues: []string{"BEST_PRACTICE", "CUSTOM"}},
+		"behaviors.manifest_personalization.hls_preferred_bitrate":                     {Pattern: "^\\d+$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_secret_key":                {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_personalization.hls_query_param_transition_key":            {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.manifest_rerouting.partner":                                         {AllowedValues: []string{"adobe_primetime"}},
+		"behaviors.manifest_rerouting.username":                                        {Pattern: "^[0-9A-Za-z!@.,;:'\"?-]{1,50}$|{{.+}}"},
+		"behaviors.media_origin_failover.cache_window":                                 {AllowedValues: []string{"ONE_S", "TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.object_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.object_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.object_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.object_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.object_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.object_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unavailable_alternate_host":            {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_backup_host":               {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_blacklist_window":          {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unavailable_detection_level":           {AllowedValues: []string{"RESPONSE_CODES"}},
+		"behaviors.media_origin_failover.origin_unavailable_modified_path":             {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unavailable_protocol":                  {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unavailable_recovery":                  {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unavailable_redirect_method":           {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unavailable_retry_limit":               {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_alternate_host":           {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_backup_host":              {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_blacklist_window":         {AllowedValues: []string{"TEN_S", "THIRTY_S"}},
+		"behaviors.media_origin_failover.origin_unresponsive_detection_level":          {AllowedValues: []string{"AGGRESSIVE", "CONSERVATIVE", "MODERATE"}},
+		"behaviors.media_origin_failover.origin_unresponsive_modified_path":            {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.media_origin_failover.origin_unresponsive_protocol":                 {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"behaviors.media_origin_failover.origin_unresponsive_recovery":                 {AllowedValues: []string{"RETRY_X_TIMES", "SWITCH_TO_BACKUP_ORIGIN", "REDIRECT_TO_DIFFERENT_ORIGIN_LOCATION"}},
+		"behaviors.media_origin_failover.origin_unresponsive_redirect_method":          {AllowedValues: []string{"301", "302"}},
+		"behaviors.media_origin_failover.origin_unresponsive_retry_limit":              {AllowedValues: []string{"ONE", "TWO", "THREE"}},
+		"behaviors.modify_incoming_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_request_header.standard_add_header_name":            {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_delete_header_name":         {AllowedValues: []string{"IF_MODIFIED_SINCE", "VIA", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_modify_header_name":         {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_request_header.standard_pass_header_name":           {AllowedValues: []string{"ACCEPT_ENCODING", "ACCEPT_LANGUAGE", "OTHER"}},
+		"behaviors.modify_incoming_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "PASS"}},
+		"behaviors.modify_incoming_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_incoming_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "EXPIRES", "LAST_MODIFIED", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "VARY", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_TYPE", "EDGE_CONTROL", "OTHER"}},
+		"behaviors.modify_incoming_response_header.standard_pass_header_name":          {AllowedValues: []string{"CACHE_CONTROL", "EXPIRES", "PRAGMA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.action":                              {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_request_header.custom_header_name":                  {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_request_header.standard_add_header_name":            {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_delete_header_name":         {AllowedValues: []string{"PRAGMA", "USER_AGENT", "VIA", "OTHER"}},
+		"behaviors.modify_outgoing_request_header.standard_modify_header_name":         {AllowedValues: []string{"USER_AGENT", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.action":                             {AllowedValues: []string{"ADD", "DELETE", "MODIFY", "REGEX"}},
+		"behaviors.modify_outgoing_response_header.custom_header_name":                 {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.modify_outgoing_response_header.standard_add_header_name":           {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EDGE_CONTROL", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_delete_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "EXPIRES", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_outgoing_response_header.standard_modify_header_name":        {AllowedValues: []string{"CACHE_CONTROL", "CONTENT_DISPOSITION", "CONTENT_TYPE", "P3P", "PRAGMA", "ACCESS_CONTROL_ALLOW_ORIGIN", "ACCESS_CONTROL_ALLOW_METHODS", "ACCESS_CONTROL_ALLOW_HEADERS", "ACCESS_CONTROL_EXPOSE_HEADERS", "ACCESS_CONTROL_ALLOW_CREDENTIALS", "ACCESS_CONTROL_MAX_AGE", "OTHER"}},
+		"behaviors.modify_via_header.modification_option":                              {AllowedValues: []string{"REMOVE_HEADER", "RENAME_HEADER"}},
+		"behaviors.modify_via_header.rename_header_to":                                 {Pattern: "^[^()<>@,;:\\\"\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.cache_key_hostname":                                          {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME"}},
+		"behaviors.origin.forward_host_header":                                         {AllowedValues: []string{"REQUEST_HOST_HEADER", "ORIGIN_HOSTNAME", "CUSTOM"}},
+		"behaviors.origin.ip_version":                                                  {AllowedValues: []string{"IPV4", "DUALSTACK", "IPV6"}},
+		"behaviors.origin.max_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.min_tls_version":                                             {AllowedValues: []string{"DYNAMIC", "TLSV1_1", "TLSV1_2", "TLSV1_3"}},
+		"behaviors.origin.origin_certs_to_honor":                                       {AllowedValues: []string{"COMBO", "STANDARD_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATE_AUTHORITIES", "CUSTOM_CERTIFICATES"}},
+		"behaviors.origin.origin_type":                                                 {AllowedValues: []string{"CUSTOMER", "NET_STORAGE", "MEDIA_SERVICE_LIVE", "EDGE_LOAD_BALANCING_ORIGIN_GROUP", "SAAS_DYNAMIC_ORIGIN"}},
+		"behaviors.origin.saas_cname_level":                                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.origin.saas_cookie":                                                 {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.origin.saas_query_string":                                           {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.origin.saas_regex":                                                  {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.origin.saas_replace":                                                {Pattern: "^(([a-zA-Z0-9]|\\$[1-9])(([a-zA-Z0-9\\._\\-]|\\$[1-9]){0,250}([a-zA-Z0-9]|\\$[1-9]))?){1,10}$|{{.+}}"},
+		"behaviors.origin.saas_suffix":                                                 {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})\\.(com|net|org|info|biz|us|co\\.uk|ac\\.uk|org\\.uk|me\\.uk|ca|eu|com\\.au|co|co\\.za|ru|es|me|tv|pro|in|ie|de|it|nl|fr|co\\.il|ch|se|co\\.nz|pl|jp|name|mobi|cc|ws|be|com\\.mx|at|nu|asia|co\\.nz|net\\.nz|org\\.nz|com\\.au|net\\.au|org\\.au|tools)$|{{.+}}"},
+		"behaviors.origin.saas_type":                                                   {AllowedValues: []string{"HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.origin.true_client_ip_header":                                       {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.origin.verification_mode":                                           {AllowedValues: []string{"PLATFORM_SETTINGS", "CUSTOM", "THIRD_PARTY"}},
+		"behaviors.origin_characteristics.authentication_method":                       {AllowedValues: []string{"AUTOMATIC", "SIGNATURE_HEADER_AUTHENTICATION", "MSL_AUTHENTICATION", "AWS", "GCS_HMAC_AUTHENTICATION", "AWS_STS"}},
+		"behaviors.origin_characteristics.aws_access_key_id":                           {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_ar_region":                               {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_host":                                    {Pattern: "^(([a-zA-Z0-9]([a-zA-Z0-9_\\-]*[a-zA-Z0-9])?)\\.)+([a-zA-Z]+|xn--[a-zA-Z0-9]+)$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_region":                                  {Pattern: "^[a-zA-Z0-9-]+$|{{.+}}"},
+		"behaviors.origin_characteristics.aws_secret_access_key":                       {Pattern: "^[a-zA-Z0-9+/=_-]{1,1024}$|{{.+}}"},
+		"behaviors.origin_characteristics.country":                                     {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST", "GLOBAL_MULTI_GEO", "OTHER", "UNKNOWN", "ADC"}},
+		"behaviors.origin_characteristics.encoding_version":                            {AllowedValues: []string{"1", "2", "3", "4", "5"}},
+		"behaviors.origin_characteristics.end_point_service":                           {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9\\-]{0,63}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_access_id":                      {Pattern: "^[a-zA-Z0-9]{1,128}$|{{.+}}"},
+		"behaviors.origin_characteristics.gcs_hmac_key_secret":                         {Pattern: "^[a-zA-Z0-9+/=_-]{1,40}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslkey":                                      {Pattern: "^[0-9a-zA-Z]{10,}$|{{.+}}"},
+		"behaviors.origin_characteristics.mslname":                                     {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.nonce":                                       {Pattern: "^[0-9a-zA-Z]{1,8}$|{{.+}}"},
+		"behaviors.origin_characteristics.role_arn":                                    {Pattern: "^[a-zA-Z0-9][a-zA-Z0-9_\\+=,.@\\-:/]{0,2047}$|{{.+}}"},
+		"behaviors.origin_characteristics.secret_key":                                  {Pattern: "^[0-9a-zA-Z]{24}$|{{.+}}"},
+		"behaviors.origin_characteristics_wsd.origintype":                              {AllowedValues: []string{"AZURE", "UNKNOWN"}},
+		"behaviors.origin_failure_recovery_method.recovery_method":                     {AllowedValues: []string{"RETRY_ALTERNATE_ORIGIN", "RESPOND_CUSTOM_STATUS"}},
+		"behaviors.origin_failure_recovery_policy.origin_responsiveness_timeout":       {AllowedValues: []string{"AGGRESSIVE", "MODERATE", "CONSERVATIVE", "USER_SPECIFIED"}},
+		"behaviors.permissions_policy.allow_list":                                      {Pattern: "^[a-zA-Z0-9_\\-*:%\\[\\]@.\\s]+$|{{.+}}"},
+		"behaviors.persistent_client_connection.timeout":                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.persistent_connection.timeout":                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.failover_duration":                                   {MinValue: ptr.To(0), MaxValue: ptr.To(300)},
+		"behaviors.phased_release.label":                                               {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.phased_release.population_cookie_type":                              {AllowedValues: []string{"NONE", "NEVER", "ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION"}},
+		"behaviors.phased_release.population_duration":                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.phased_release.population_expiration_date":                          {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.predictive_prefetching.accuracy_target":                             {AllowedValues: []string{"LOW", "MEDIUM", "HIGH"}},
+		"behaviors.prefresh_cache.prefreshval":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(99)},
+		"behaviors.quality.catalog_size":                                               {AllowedValues: []string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}},
+		"behaviors.quality.content_type":                                               {AllowedValues: []string{"NONE", "SITE", "IMAGES", "CONFIG", "OTHERS", "AUDIO", "SD_VIDEO", "HD_VIDEO", "SUPER_HD_VIDEO", "LARGE_OBJECTS"}},
+		"behaviors.quality.country":                                                    {AllowedValues: []string{"EUROPE", "NORTH_AMERICA", "LATIN_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "OTHER_AMERICAS", "OTHER_APJ", "OTHER_EMEA", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "MEXICO", "TAIWAN", "UNITED_KINGDOM", "US_EAST", "US_CENTRAL", "US_WEST"}},
+		"behaviors.quality.delivery_format":                                            {AllowedValues: []string{"DASH", "HDS", "HLS", "SILVER_LIGHT", "OTHER"}},
+		"behaviors.quality.delivery_type":                                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.quality.download_type":                                              {AllowedValues: []string{"FOREGROUND", "BACKGROUND"}},
+		"behaviors.quality.end_user_location":                                          {AllowedValues: []string{"GLOBAL", "GLOBAL_US_CENTRIC", "GLOBAL_EU_CENTRIC", "GLOBAL_ASIA_CENTRIC", "EUROPE", "NORTH_AMERICA", "SOUTH_AMERICA", "NORDICS", "ASIA_PACIFIC", "AUSTRALIA", "GERMANY", "INDIA", "ITALY", "JAPAN", "TAIWAN", "UNITED_KINGDOM"}},
+		"behaviors.quality.maximum_concurrent_users":                                   {AllowedValues: []string{"NONE", "LESS_THAN_10K", "10K_TO_50K", "50K_TO_100K", "GREATER_THAN_100K"}},
+		"behaviors.quality.object_size":                                                {AllowedValues: []string{"LESS_THAN_1MB", "1_TO_10MB", "10_TO_100MB", "GREATER_THAN_100MB"}},
+		"behaviors.quality.optimize_for":                                               {AllowedValues: []string{"NONE", "ORIGIN", "STARTUP"}},
+		"behaviors.quality.popularity_distribution":                                    {AllowedValues: []string{"TYPICAL", "LONG_TAIL", "ALL_POPULAR", "ALL_UNPOPULAR"}},
+		"behaviors.quality.refresh_rate":                                               {AllowedValues: []string{"NONE", "HOURLY", "DAILY", "MONTHLY", "YEARLY"}},
+		"behaviors.quality.segment_duration":                                           {AllowedValues: []string{"2", "4", "6", "8", "10"}},
+		"behaviors.quic_beta.quic_offer_percentage":                                    {MinValue: ptr.To(1), MaxValue: ptr.To(50)},
+		"behaviors.random_seek.maximum_size":                                           {Pattern: "^\\d+[K,M,G,T]B$|{{.+}}"},
+		"behaviors.read_timeout.first_byte_timeout":                                    {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.read_timeout.value":                                                 {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.redirect.destination_hostname":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "SUBDOMAIN", "SIBLING", "OTHER"}},
+		"behaviors.redirect.destination_hostname_other":                                {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.redirect.destination_path":                                          {AllowedValues: []string{"SAME_AS_REQUEST", "PREFIX_REQUEST", "OTHER"}},
+		"behaviors.redirect.destination_path_other":                                    {Pattern: "^/|{{.+}}"},
+		"behaviors.redirect.destination_path_prefix":                                   {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix":                                   {Pattern: "^[a-zA-Z0-9\\[\\]/?#!=&_\\-\\.]+$|{{.+}}"},
+		"behaviors.redirect.destination_path_suffix_status":                            {AllowedValues: []string{"NO_SUFFIX", "SUFFIX"}},
+		"behaviors.redirect.destination_protocol":                                      {AllowedValues: []string{"SAME_AS_REQUEST", "HTTP", "HTTPS"}},
+		"behaviors.redirect.mobile_default_choice":                                     {AllowedValues: []string{"DEFAULT", "MOBILE"}},
+		"behaviors.redirect.response_code":                                             {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.redirectplus.response_code":                                         {AllowedValues: []string{"301", "302", "303", "307"}},
+		"behaviors.report.log_cookies":                                                 {AllowedValues: []string{"OFF", "ALL", "SOME"}},
+		"behaviors.request_control.branded403_file":                                    {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.request_control.branded403_status_code":                             {AllowedValues: []string{"200", "302", "403", "503"}},
+		"behaviors.request_control.branded403_url":                                     {Pattern: "^[^\\s]+$|{{.+}}"},
+		"behaviors.request_control.branded_deny_cache_ttl":                             {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.request_type_marker.request_type":                                   {AllowedValues: []string{"DOWNLOAD", "CAMPAIGN_SERVER"}},
+		"behaviors.response_code.status_code":                                          {AllowedValues: []string{"200", "301", "302", "303", "404", "500", "100", "101", "102", "103", "122", "201", "202", "203", "204", "205", "206", "207", "226", "300", "304", "305", "306", "307", "308", "400", "401", "402", "403", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415", "416", "417", "422", "423", "424", "425", "426", "428", "429", "431", "444", "449", "450", "499", "501", "502", "503", "504", "505", "506", "507", "509", "510", "511", "598", "599"}},
+		"behaviors.response_cookie.cookie_name":                                        {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.response_cookie.domain":                                             {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.response_cookie.duration":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.response_cookie.expiration_date":                                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.response_cookie.expires":                                            {AllowedValues: []string{"ON_BROWSER_CLOSE", "FIXED_DATE", "DURATION", "NEVER"}},
+		"behaviors.response_cookie.format":                                             {AllowedValues: []string{"AKAMAI", "APACHE"}},
+		"behaviors.response_cookie.path":                                               {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.response_cookie.same_site":                                          {AllowedValues: []string{"DEFAULT", "NONE", "LAX", "STRICT"}},
+		"behaviors.response_cookie.type":                                               {AllowedValues: []string{"FIXED", "UNIQUE"}},
+		"behaviors.response_cookie.value":                                              {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"behaviors.return_cache_status.response_header_name":                           {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"behaviors.rewrite_url.behavior":                                               {AllowedValues: []string{"REPLACE", "REMOVE", "REWRITE", "PREPEND", "REGEX_REPLACE"}},
+		"behaviors.rewrite_url.match":                                                  {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path":                                            {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_path_prepend":                                    {Pattern: "^/([^:#\\[\\]@/?]+/)*$|{{.+}}"},
+		"behaviors.rewrite_url.target_url":                                             {Pattern: "(/\\S*)?$|{{.+}}"},
+		"behaviors.rum_custom.rum_group_name":                                          {Pattern: "^[0-9a-zA-Z]*$|{{.+}}"},
+		"behaviors.rum_custom.rum_sample_rate":                                         {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"behaviors.saas_definitions.application_action":                                {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.application_cname_level":                           {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_cookie":                                {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_query_string":                          {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.application_regex":                                 {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.application_replace":                               {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_action":                                   {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.customer_cname_level":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_cookie":                                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_query_string":                             {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.customer_regex":                                    {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.customer_replace":                                  {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_action":                                      {AllowedValues: []string{"DISABLED", "HOSTNAME", "PATH", "QUERY_STRING", "COOKIE"}},
+		"behaviors.saas_definitions.users_cname_level":                                 {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_cookie":                                      {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_query_string":                                {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"behaviors.saas_definitions.users_regex":                                       {Pattern: "^([a-zA-Z0-9\\:\\[\\]\\{\\}\\(\\)\\.\\?_\\-\\*\\+\\^\\$\\\\\\/\\|&=!]{1,250})$|{{.+}}"},
+		"behaviors.saas_definitions.users_replace":                                     {Pattern: "^(([a-zA-Z0-9_\\-]|\\$[1-9]){1,250})$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.connector_id":                     {Pattern: "^[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9_\\.]+\\-[a-zA-Z0-9\\-_\\.]+$|^door2.dw.com$|{{.+}}"},
+		"behaviors.sales_force_commerce_cloud_client.origin_host_header":               {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_client.origin_type":                      {AllowedValues: []string{"DEFAULT", "CUSTOMER"}},
+		"behaviors.sales_force_commerce_cloud_provider_host_header.host_header_source": {AllowedValues: []string{"PROPERTY", "CUSTOMER"}},
+		"behaviors.schedule_invalidation.refresh_method":                               {AllowedValues: []string{"INVALIDATE", "PURGE"}},
+		"behaviors.schedule_invalidation.repeat_interval":                              {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.script_management.serviceworker":                                    {AllowedValues: []string{"YES_SERVICE_WORKER", "NO_SERVICE_WORKER"}},
+		"behaviors.segmented_content_protection.key":                                   {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_content_protection.salt":                                  {},
+		"behaviors.segmented_content_protection.transition_key":                        {Pattern: "^(0x)?[0-9a-fA-F]{32}$|{{.+}}"},
+		"behaviors.segmented_media_optimization.behavior":                              {AllowedValues: []string{"ON_DEMAND", "LIVE"}},
+		"behaviors.segmented_media_optimization.dvr_type":                              {AllowedValues: []string{"CONFIGURABLE", "UNKNOWN"}},
+		"behaviors.segmented_media_optimization.dvr_window":                            {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.segmented_media_optimization.end_time":                              {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.segmented_media_optimization.live_type":                             {AllowedValues: []string{"CONTINUOUS", "EVENT"}},
+		"behaviors.segmented_media_optimization.start_time":                            {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.set_variable.algorithm":                                             {AllowedValues: []string{"ALG_3DES", "ALG_AES128", "ALG_AES256"}},
+		"behaviors.set_variable.certificate_field_name":                                {AllowedValues: []string{"VERSION", "SERIAL", "FINGERPRINT_MD5", "FINGERPRINT_SHA1", "FINGERPRINT_DYN", "ISSUER_DN", "SUBJECT_DN", "NOT_BEFORE", "NOT_AFTER", "SIGNATURE_ALGORITHM", "SIGNATURE", "CONTENTS_DER", "CONTENTS_PEM", "CONTENTS_PEM_NO_LABELS", "COUNT", "STATUS_MSG", "KEY_LENGTH"}},
+		"behaviors.set_variable.device_profile":                                        {AllowedValues: []string{"IS_MOBILE", "IS_TABLET", "IS_WIRELESS_DEVICE", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "RESOLUTION_HEIGHT", "RESOLUTION_WIDTH", "VIEWPORT_WIDTH", "BRAND_NAME", "DEVICE_OS", "DEVICE_OS_VERSION", "DUAL_ORIENTATION", "MAX_IMAGE_HEIGHT", "MAX_IMAGE_WIDTH", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "PDF_SUPPORT", "COOKIE_SUPPORT"}},
+		"behaviors.set_variable.encryption_key":                                        {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.encryption_mode":                                       {AllowedValues: []string{"CBC", "ECB"}},
+		"behaviors.set_variable.extract_location":                                      {AllowedValues: []string{"CLIENT_CERTIFICATE", "CLIENT_REQUEST_HEADER", "COOKIE", "EDGESCAPE", "PATH_COMPONENT_OFFSET", "QUERY_STRING", "DEVICE_PROFILE", "RESPONSE_HEADER", "SET_COOKIE"}},
+		"behaviors.set_variable.generator":                                             {AllowedValues: []string{"HEXRAND", "RAND"}},
+		"behaviors.set_variable.hmac_algorithm":                                        {AllowedValues: []string{"SHA1", "SHA256", "MD5"}},
+		"behaviors.set_variable.initialization_vector":                                 {Pattern: "^(0x)?[0-9a-fA-F]+$|{{.+}}"},
+		"behaviors.set_variable.ip_version":                                            {AllowedValues: []string{"IPV4", "IPV6"}},
+		"behaviors.set_variable.ipv4_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(32)},
+		"behaviors.set_variable.ipv6_prefix":                                           {MinValue: ptr.To(0), MaxValue: ptr.To(128)},
+		"behaviors.set_variable.location_id":                                           {AllowedValues: []string{"GEOREGION", "COUNTRY_CODE", "REGION_CODE", "CITY", "DMA", "PMSA", "MSA", "AREACODE", "COUNTY", "FIPS", "LAT", "LONG", "TIMEZONE", "ZIP", "CONTINENT", "NETWORK", "NETWORK_TYPE", "ASNUM", "THROUGHPUT", "BW"}},
+		"behaviors.set_variable.number_of_bytes":                                       {MinValue: ptr.To(1), MaxValue: ptr.To(16)},
+		"behaviors.set_variable.transform":                                             {AllowedValues: []string{"NONE", "ADD", "BASE_64_DECODE", "BASE_64_ENCODE", "BASE_32_DECODE", "BASE_32_ENCODE", "BITWISE_AND", "BITWISE_NOT", "BITWISE_OR", "BITWISE_XOR", "DECIMAL_TO_HEX", "DECRYPT", "DIVIDE", "ENCRYPT", "EPOCH_TO_STRING", "EXTRACT_PARAM", "HASH", "JSON_EXTRACT", "HEX_TO_DECIMAL", "HEX_DECODE", "HEX_ENCODE", "HMAC", "LOWER", "MD5", "MINUS", "MODULO", "MULTIPLY", "NORMALIZE_PATH_WIN", "REMOVE_WHITESPACE", "COMPRESS_WHITESPACE", "SHA_1", "SHA_256", "STRING_INDEX", "STRING_LENGTH", "STRING_TO_EPOCH", "SUBSTITUTE", "SUBSTRING", "SUBTRACT", "TRIM", "UPPER", "BASE_64_URL_DECODE", "BASE_64_URL_ENCODE", "URL_DECODE", "URL_ENCODE", "URL_DECODE_UNI", "UTC_SECONDS", "XML_DECODE", "XML_ENCODE"}},
+		"behaviors.set_variable.value_source":                                          {AllowedValues: []string{"EXPRESSION", "EXTRACT", "GENERATE"}},
+		"behaviors.simulate_error_code.error_type":                                     {AllowedValues: []string{"ERR_DNS_TIMEOUT", "ERR_SUREROUTE_DNS_FAIL", "ERR_DNS_FAIL", "ERR_CONNECT_TIMEOUT", "ERR_NO_GOOD_FWD_IP", "ERR_DNS_IN_REGION", "ERR_CONNECT_FAIL", "ERR_READ_TIMEOUT", "ERR_READ_ERROR", "ERR_WRITE_ERROR"}},
+		"behaviors.simulate_error_code.timeout":                                        {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_duration":                      {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.cache_sharing_start_time":                    {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_duration":                          {Pattern: "^[1-9]$|^[1-2]\\d$|^30$|{{.+}}"},
+		"behaviors.standard_tls_migration.migration_from":                              {AllowedValues: []string{"SHARED_CERT", "NON_SECURE", "ENHANCED_SECURE"}},
+		"behaviors.standard_tls_migration.migration_start_time":                        {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.standard_tls_migration.td_location":                                 {AllowedValues: []string{"GLOBAL", "APAC", "EUROPE", "US_EAST", "US_CENTRAL", "US_WEST", "AUSTRALIA", "GLOBAL_LEGACY"}},
+		"behaviors.sure_route.custom_stat_key":                                         {Pattern: "^([a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})(\\.[a-zA-Z0-9][a-zA-Z0-9\\-]{0,62})+$|{{.+}}"},
+		"behaviors.sure_route.race_stat_ttl":                                           {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.sure_route.test_object_url":                                         {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.sure_route.to_host_status":                                          {AllowedValues: []string{"INCOMING_HH", "OTHER"}},
+		"behaviors.sure_route.type":                                                    {AllowedValues: []string{"PERFORMANCE", "CUSTOM_MAP"}},
+		"behaviors.tiered_distribution.tiered_distribution_map":                        {AllowedValues: []string{"CH2", "CHAPAC", "CHEU2", "CHEUS2", "CHCUS2", "CHWUS2", "CHAUS", "CH"}},
+		"behaviors.tiered_distribution_advanced.method":                                {AllowedValues: []string{"SERIAL_PREPEND", "DOMAIN_LOOKUP"}},
+		"behaviors.tiered_distribution_advanced.policy":                                {AllowedValues: []string{"PERFORMANCE", "TIERED_DISTRIBUTION", "FAIL_OVER", "SITE_SHIELD", "SITE_SHIELD_PERFORMANCE"}},
+		"behaviors.tiered_distribution_customization.custom_map_name":                  {Pattern: "^(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+(akamai|akamaiedge)\\.net$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.hash_algorithm":                   {AllowedValues: []string{"GCC", "JENKINS"}},
+		"behaviors.tiered_distribution_customization.migration_end_date":               {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.tiered_distribution_customization.migration_start_date":             {Pattern: "^[0-9]+$|{{.+}}"},
+		"behaviors.timeout.value":                                                      {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"behaviors.uid_configuration.extract_location":                                 {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "VARIABLE"}},
+		"behaviors.verify_json_web_token.extract_location":                             {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.authorizations":                       {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.client_id":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_json_web_token_for_dcp.extract_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING", "CLIENT_REQUEST_HEADER_AND_QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.primary_location":                     {AllowedValues: []string{"CLIENT_REQUEST_HEADER", "QUERY_STRING"}},
+		"behaviors.verify_json_web_token_for_dcp.user_name":                            {Pattern: "^[a-zA-Z0-9_-]{1,20}$|{{.+}}"},
+		"behaviors.verify_token_authorization.algorithm":                               {AllowedValues: []string{"SHA256", "SHA1", "MD5"}},
+		"behaviors.verify_token_authorization.key":                                     {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.verify_token_authorization.location":                                {AllowedValues: []string{"COOKIE", "QUERY_STRING", "CLIENT_REQUEST_HEADER"}},
+		"behaviors.verify_token_authorization.salt":                                    {},
+		"behaviors.verify_token_authorization.transition_key":                          {Pattern: "^(0x)?[0-9a-fA-F]{64}$|{{.+}}"},
+		"behaviors.virtual_waiting_room.custom_cookie_domain":                          {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.virtual_waiting_room.domain_config":                                 {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.virtual_waiting_room.session_duration":                              {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.allowed_user_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(600)},
+		"behaviors.visitor_prioritization.allowed_user_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.allowed_user_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.user_identification_key_cookie":              {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cache_ttl":                      {MinValue: ptr.To(5), MaxValue: ptr.To(30)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain":                  {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_domain_type":             {AllowedValues: []string{"DYNAMIC", "CUSTOMER"}},
+		"behaviors.visitor_prioritization.waiting_room_cookie_duration":                {MinValue: ptr.To(0), MaxValue: ptr.To(120)},
+		"behaviors.visitor_prioritization.waiting_room_cookie_label":                   {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_cookie_salt":                    {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_directory":                      {Pattern: "^[^#\\[\\]@]+$|{{.+}}"},
+		"behaviors.visitor_prioritization.waiting_room_status_code":                    {Pattern: "[2|4|5][0-9][0-9]|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.custom_cookie_domain":                   {Pattern: "^(\\.)?(([a-zA-Z]|[a-zA-Z][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)+([A-Za-z]|[A-Za-z][A-Za-z0-9\\-]*[A-Za-z0-9])$|{{.+}}"},
+		"behaviors.visitor_prioritization_fifo.domain_config":                          {AllowedValues: []string{"HOST_HEADER", "CUSTOM"}},
+		"behaviors.visitor_prioritization_fifo.session_duration":                       {MinValue: ptr.To(0), MaxValue: ptr.To(86400)},
+		"behaviors.watermarking.ab_variant_location":                                   {AllowedValues: []string{"FILENAME_PREFIX", "PARENT_DIRECTORY_PREFIX"}},
+		"criteria.advanced_im_match.match_on":                                          {AllowedValues: []string{"ANY_IM", "PRISTINE"}},
+		"criteria.advanced_im_match.match_operator":                                    {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.bucket.percentage":                                                   {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.cacheability.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.cacheability.value":                                                  {AllowedValues: []string{"NO_STORE", "BYPASS_CACHE", "CACHEABLE"}},
+		"criteria.china_cdn_region.match_operator":                                     {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.client_certificate.is_certificate_valid":                             {AllowedValues: []string{"VALID", "INVALID", "IGNORE"}},
+		"criteria.client_ip.match_operator":                                            {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.client_ip_version.value":                                             {AllowedValues: []string{"IPV4", "IPV6"}},
+		"criteria.cloudlets_origin.origin_id":                                          {Pattern: "^[a-zA-Z0-9_\\-\\.]+$|{{.+}}"},
+		"criteria.content_delivery_network.match_operator":                             {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.content_delivery_network.network":                                    {AllowedValues: []string{"STAGING", "PRODUCTION"}},
+		"criteria.content_type.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.device_characteristic.characteristic":                                {AllowedValues: []string{"BRAND_NAME", "MODEL_NAME", "MARKETING_NAME", "IS_WIRELESS_DEVICE", "IS_TABLET", "DEVICE_OS", "DEVICE_OS_VERSION", "MOBILE_BROWSER", "MOBILE_BROWSER_VERSION", "RESOLUTION_WIDTH", "RESOLUTION_HEIGHT", "PHYSICAL_SCREEN_HEIGHT", "PHYSICAL_SCREEN_WIDTH", "COOKIE_SUPPORT", "AJAX_SUPPORT_JAVASCRIPT", "FULL_FLASH_SUPPORT", "ACCEPT_THIRD_PARTY_COOKIE", "XHTML_SUPPORT_LEVEL", "IS_MOBILE"}},
+		"criteria.device_characteristic.numeric_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.device_characteristic.string_match_operator":                         {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.device_characteristic.version_match_operator":                        {AllowedValues: []string{"IS", "IS_NOT", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL", "IS_MORE_THAN", "IS_MORE_THAN_OR_EQUAL"}},
+		"criteria.ecmd_auth_groups.match_operator":                                     {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN"}},
+		"criteria.ecmd_auth_groups.value":                                              {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.ecmd_auth_scheme.auth_scheme":                                        {AllowedValues: []string{"ANONYMOUS", "JWT", "MUTUAL"}},
+		"criteria.ecmd_is_authenticated.match_operator":                                {AllowedValues: []string{"IS_AUTHENTICATED", "IS_NOT_AUTHENTICATED"}},
+		"criteria.ecmd_username.length":                                                {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.ecmd_username.match_operator":                                        {AllowedValues: []string{"CONTAINS", "DOES_NOT_CONTAIN", "STARTS_WITH", "DOES_NOT_START_WITH", "ENDS_WITH", "DOES_NOT_END_WITH", "LENGTH_EQUALS", "LENGTH_GREATER_THAN", "LENGTH_SMALLER_THAN"}},
+		"criteria.ecmd_username.value":                                                 {Pattern: "^[a-zA-Z0-9_-]{1,255}$|{{.+}}"},
+		"criteria.edge_workers_failure.exec_status":                                    {AllowedValues: []string{"FAILURE", "SUCCESS"}},
+		"criteria.file_extension.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.filename.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY"}},
+		"criteria.hostname.match_operator":                                             {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.match_response_code.lower_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_response_code.match_operator":                                  {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "IS_BETWEEN", "IS_NOT_BETWEEN"}},
+		"criteria.match_response_code.upper_bound":                                     {Pattern: "^\\d{3}$|{{.+}}"},
+		"criteria.match_variable.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "IS_ONE_OF", "IS_NOT_ONE_OF", "IS_EMPTY", "IS_NOT_EMPTY", "IS_BETWEEN", "IS_NOT_BETWEEN", "IS_GREATER_THAN", "IS_GREATER_THAN_OR_EQUAL_TO", "IS_LESS_THAN", "IS_LESS_THAN_OR_EQUAL_TO"}},
+		"criteria.match_variable.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.match_variable.variable_name":                                        {Pattern: "^[a-zA-Z_][a-zA-Z0-9_]{0,31}$|{{.+}}"},
+		"criteria.metadata_stage.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.metadata_stage.value":                                                {AllowedValues: []string{"cache-hit", "client-done", "client-request", "client-request-body", "client-response", "content-policy", "forward-request", "forward-response", "forward-start", "ipa-response"}},
+		"criteria.origin_timeout.match_operator":                                       {AllowedValues: []string{"ORIGIN_TIMED_OUT"}},
+		"criteria.path.match_operator":                                                 {AllowedValues: []string{"MATCHES_ONE_OF", "DOES_NOT_MATCH_ONE_OF"}},
+		"criteria.query_string_parameter.lower_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.query_string_parameter.match_operator":                               {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.query_string_parameter.parameter_name":                               {Pattern: "^[^:/?#\\[\\]@&]+$|{{.+}}"},
+		"criteria.query_string_parameter.upper_bound":                                  {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.random.bucket":                                                       {MinValue: ptr.To(0), MaxValue: ptr.To(100)},
+		"criteria.recovery_config.config_name":                                         {Pattern: "^[A-Z0-9-]+$|{{.+}}"},
+		"criteria.request_cookie.cookie_name":                                          {Pattern: "^[a-zA-Z0-9_\\-*\\.]+$|{{.+}}"},
+		"criteria.request_cookie.lower_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT", "EXISTS", "DOES_NOT_EXIST", "IS_BETWEEN"}},
+		"criteria.request_cookie.upper_bound":                                          {Pattern: "^[1-9]\\d*$|{{.+}}"},
+		"criteria.request_cookie.value":                                                {Pattern: "^[^\\s;]+$|{{.+}}"},
+		"criteria.request_header.header_name":                                          {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.request_header.match_operator":                                       {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST"}},
+		"criteria.request_method.match_operator":                                       {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_method.value":                                                {AllowedValues: []string{"GET", "POST", "HEAD", "PUT", "PATCH", "HTTP_DELETE", "AKAMAI_TRANSLATE", "AKAMAI_PURGE", "OPTIONS", "DAV_ACL", "DAV_CHECKOUT", "DAV_COPY", "DAV_DMCREATE", "DAV_DMINDEX", "DAV_DMMKPATH", "DAV_DMMKPATHS", "DAV_DMOVERLAY", "DAV_DMPATCHPATHS", "DAV_LOCK", "DAV_MKCALENDAR", "DAV_MKCOL", "DAV_MOVE", "DAV_PROPFIND", "DAV_PROPPATCH", "DAV_REPORT", "DAV_SETPROCESS", "DAV_SETREDIRECT", "DAV_TRUTHGET", "DAV_UNLOCK"}},
+		"criteria.request_protocol.value":                                              {AllowedValues: []string{"HTTP", "HTTPS"}},
+		"criteria.request_type.match_operator":                                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.request_type.value":                                                  {AllowedValues: []string{"CLIENT_REQ", "ESI_FRAGMENT", "EW_SUBREQUEST"}},
+		"criteria.response_header.header_name":                                         {Pattern: "^[^()<>@,;:\\\"/\\[\\]?{}\\s]+$|{{.+}}"},
+		"criteria.response_header.lower_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.response_header.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF", "EXISTS", "DOES_NOT_EXIST", "IS_LESS_THAN", "IS_MORE_THAN", "IS_BETWEEN"}},
+		"criteria.response_header.upper_bound":                                         {Pattern: "^[0-9]+$|{{.+}}"},
+		"criteria.server_location.location_type":                                       {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.server_location.match_operator":                                      {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.time.lasting_duration":                                               {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.match_operator":                                                 {AllowedValues: []string{"BEGINNING", "BETWEEN", "LASTING", "REPEATING"}},
+		"criteria.time.repeat_duration":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.time.repeat_interval":                                                {Pattern: "^[0-9]+[DdHhMmSs]$|{{.+}}"},
+		"criteria.token_authorization.match_operator":                                  {AllowedValues: []string{"IS_SUCCESS", "IS_CUSTOM_FAILURE", "IS_ANY_FAILURE"}},
+		"criteria.user_agent.match_operator":                                           {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_location.check_ips":                                             {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_location.field":                                                 {AllowedValues: []string{"COUNTRY", "CONTINENT", "REGION"}},
+		"criteria.user_location.match_operator":                                        {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.user_network.check_ips":                                              {AllowedValues: []string{"BOTH", "CONNECTING", "HEADERS"}},
+		"criteria.user_network.field":                                                  {AllowedValues: []string{"NETWORK", "NETWORK_TYPE", "BANDWIDTH"}},
+		"criteria.user_network.match_operator":                                         {AllowedValues: []string{"IS_ONE_OF", "IS_NOT_ONE_OF"}},
+		"criteria.virtual_waiting_room_request.match_on":                               {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.virtual_waiting_room_request.match_operator":                         {AllowedValues: []string{"IS", "IS_NOT"}},
+		"criteria.visitor_prioritization_request.match_on":                             {AllowedValues: []string{"WR_ANY", "WR_MAIN_PAGE", "WR_ASSETS"}},
+		"criteria.visitor_prioritization_request.match_operator":                       {AllowedValues: []string{"IS", "IS_NOT"}},
+	},
+}
@@ -35,6 +35,8 @@ var (
 	ErrOnlyForDefault = errors.New("cannot be used outside 'default' rule")
 	// ErrNotForDefault is used when some fields cannot be used in "default" rules in data source
 	ErrNotForDefault = errors.New("cannot be used in 'default' rule")
+	// ErrRuleFormatNotSupported is used when given rule format is not present in the registry
+	ErrRuleFormatNotSupported = errors.New("rule format is not supported")
 )
 
 // Error returns NotFoundError as a string.
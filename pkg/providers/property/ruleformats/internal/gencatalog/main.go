@@ -0,0 +1,331 @@
+// Package main generates option constraints of the rule format catalog from the validators
+// declared in the generated rule format schemas.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	constraints struct {
+		AllowedValues []string
+		Pattern       string
+		MinValue      *int
+		MaxValue      *int
+	}
+
+	// ruleFormat maps "behaviors.<name>.<option>" and "criteria.<name>.<option>" keys to option constraints
+	ruleFormat struct {
+		version     string
+		constraints map[string]constraints
+	}
+)
+
+// internalOptions are not exposed in the catalog, see ruleformats.internalOptions
+var internalOptions = map[string]struct{}{"locked": {}, "uuid": {}, "template_uuid": {}}
+
+func main() {
+	dir := flag.String("dir", ".", "directory containing the rule format schemas")
+	out := flag.String("out", "catalog_constraints.gen.go", "output file")
+	flag.Parse()
+
+	src, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns source of the constraints table built from all rule format schemas in dir
+func generate(dir string) ([]byte, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "rule_format_v*.gen.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	formats := make([]ruleFormat, 0, len(files))
+	for _, file := range files {
+		rf, err := parseRuleFormat(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		formats = append(formats, *rf)
+	}
+
+	return render(formats)
+}
+
+func parseRuleFormat(file string) (*ruleFormat, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.TrimSuffix(filepath.Base(file), ".gen.go")
+	rf := &ruleFormat{
+		version:     "rules_" + strings.TrimPrefix(base, "rule_format_"),
+		constraints: map[string]constraints{},
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		var kind string
+		switch {
+		case strings.HasPrefix(fn.Name.Name, "getBehaviorsSchema"):
+			kind = "behaviors"
+		case strings.HasPrefix(fn.Name.Name, "getCriteriaSchema"):
+			kind = "criteria"
+		default:
+			continue
+		}
+		ret, ok := fn.Body.List[len(fn.Body.List)-1].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return nil, fmt.Errorf("unexpected body of %s", fn.Name.Name)
+		}
+		items, ok := ret.Results[0].(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected result of %s", fn.Name.Name)
+		}
+		for _, item := range schemaEntries(items) {
+			options, ok := elemSchema(item.value)
+			if !ok {
+				continue
+			}
+			for _, option := range schemaEntries(options) {
+				if _, ok := internalOptions[option.name]; ok {
+					continue
+				}
+				c, err := optionConstraints(option.value)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s.%s: %w", kind, item.name, option.name, err)
+				}
+				if c != nil {
+					rf.constraints[fmt.Sprintf("%s.%s.%s", kind, item.name, option.name)] = *c
+				}
+			}
+		}
+	}
+
+	return rf, nil
+}
+
+type schemaEntry struct {
+	name  string
+	value *ast.CompositeLit
+}
+
+// schemaEntries returns entries of a map[string]*schema.Schema literal
+func schemaEntries(lit *ast.CompositeLit) []schemaEntry {
+	var entries []schemaEntry
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		value, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		name, _ := strconv.Unquote(key.Value)
+		entries = append(entries, schemaEntry{name: name, value: value})
+	}
+	return entries
+}
+
+// elemSchema returns the schema map literal of the Elem: &schema.Resource{Schema: ...} field
+func elemSchema(lit *ast.CompositeLit) (*ast.CompositeLit, bool) {
+	elem, ok := field(lit, "Elem")
+	if !ok {
+		return nil, false
+	}
+	unary, ok := elem.(*ast.UnaryExpr)
+	if !ok {
+		return nil, false
+	}
+	res, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return nil, false
+	}
+	s, ok := field(res, "Schema")
+	if !ok {
+		return nil, false
+	}
+	schemaLit, ok := s.(*ast.CompositeLit)
+	return schemaLit, ok
+}
+
+func field(lit *ast.CompositeLit, name string) (ast.Expr, bool) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == name {
+			return kv.Value, true
+		}
+	}
+	return nil, false
+}
+
+func optionConstraints(option *ast.CompositeLit) (*constraints, error) {
+	validator, ok := field(option, "ValidateDiagFunc")
+	if !ok {
+		return nil, nil
+	}
+	c := &constraints{}
+	if err := addValidator(c, validator); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// addValidator adds constraints enforced by one of the validators used in the rule format schemas
+func addValidator(c *constraints, expr ast.Expr) error {
+	// validators without arguments are passed as function values
+	if _, ok := expr.(*ast.CallExpr); !ok {
+		expr = &ast.CallExpr{Fun: expr}
+	}
+	call := expr.(*ast.CallExpr)
+	switch name := funcName(call); name {
+	case "validation.ToDiagFunc":
+		return addValidator(c, call.Args[0])
+	case "validateAny":
+		for _, arg := range call.Args {
+			if err := addValidator(c, arg); err != nil {
+				return err
+			}
+		}
+	case "validateRegex", "validateRegexOrVariable":
+		pattern, err := literal(call.Args[0])
+		if err != nil {
+			return err
+		}
+		// keep in sync with validateRegexOrVariable
+		if name == "validateRegexOrVariable" {
+			pattern += "|{{.+}}"
+		}
+		if c.Pattern != "" {
+			pattern = c.Pattern + "|" + pattern
+		}
+		c.Pattern = pattern
+	case "validation.StringInSlice", "validation.IntInSlice":
+		values, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return fmt.Errorf("unsupported argument of %s", name)
+		}
+		for _, v := range values.Elts {
+			value, err := literal(v)
+			if err != nil {
+				return err
+			}
+			c.AllowedValues = append(c.AllowedValues, value)
+		}
+	case "validation.IntBetween":
+		minValue, err := intArg(call.Args[0])
+		if err != nil {
+			return err
+		}
+		maxValue, err := intArg(call.Args[1])
+		if err != nil {
+			return err
+		}
+		c.MinValue, c.MaxValue = &minValue, &maxValue
+	case "validation.StringIsEmpty", "validation.StringLenBetween":
+		// not exposed in the catalog
+	default:
+		return fmt.Errorf("unsupported validator %s", name)
+	}
+	return nil
+}
+
+func funcName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		if pkg, ok := fn.X.(*ast.Ident); ok {
+			return pkg.Name + "." + fn.Sel.Name
+		}
+	}
+	return ""
+}
+
+func literal(expr ast.Expr) (string, error) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		value, err := literal(unary.X)
+		return "-" + value, err
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return "", fmt.Errorf("unsupported literal %T", expr)
+	}
+	if lit.Kind == token.STRING {
+		return strconv.Unquote(lit.Value)
+	}
+	return lit.Value, nil
+}
+
+func intArg(expr ast.Expr) (int, error) {
+	value, err := literal(expr)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
+
+func render(formats []ruleFormat) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gencatalog; DO NOT EDIT.\n\n")
+	buf.WriteString("package ruleformats\n\n")
+	buf.WriteString("import \"github.com/akamai/terraform-provider-akamai/v6/pkg/common/ptr\"\n\n")
+	buf.WriteString("// catalogConstraints maps rule format versions to constraints of behavior and criterion options.\n")
+	buf.WriteString("// Keys of the inner maps are in the \"behaviors.<name>.<option>\" or \"criteria.<name>.<option>\" form.\n")
+	buf.WriteString("var catalogConstraints = map[string]map[string]optionConstraints{\n")
+	for _, rf := range formats {
+		fmt.Fprintf(&buf, "%q: {\n", rf.version)
+		keys := make([]string, 0, len(rf.constraints))
+		for key := range rf.constraints {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			c := rf.constraints[key]
+			fmt.Fprintf(&buf, "%q: {", key)
+			if c.AllowedValues != nil {
+				fmt.Fprintf(&buf, "AllowedValues: %#v, ", c.AllowedValues)
+			}
+			if c.Pattern != "" {
+				fmt.Fprintf(&buf, "Pattern: %q, ", c.Pattern)
+			}
+			if c.MinValue != nil {
+				fmt.Fprintf(&buf, "MinValue: ptr.To(%d), MaxValue: ptr.To(%d)", *c.MinValue, *c.MaxValue)
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
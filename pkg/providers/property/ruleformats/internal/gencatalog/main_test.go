@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	generated, err := generate("../..")
+	require.NoError(t, err)
+
+	current, err := os.ReadFile("../../catalog_constraints.gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(current), string(generated), "catalog_constraints.gen.go is outdated, run go generate")
+}
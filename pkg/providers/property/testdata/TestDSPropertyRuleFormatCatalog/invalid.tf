@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rule_format_catalog" "catalog" {
+  rule_format = "latest"
+}
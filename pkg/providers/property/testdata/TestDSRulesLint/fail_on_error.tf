@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_lint" "lint" {
+  rules   = file("testdata/TestDSRulesLint/rules.json")
+  fail_on = "error"
+}
@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_lint" "lint" {
+  rules = file("testdata/TestDSRulesLint/rules.json")
+
+  policy {
+    check    = "unknown"
+    severity = "off"
+  }
+}
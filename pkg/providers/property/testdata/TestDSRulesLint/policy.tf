@@ -0,0 +1,20 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_lint" "lint" {
+  rules = file("testdata/TestDSRulesLint/rules.json")
+
+  policy {
+    check    = "missing_cp_code"
+    severity = "off"
+  }
+  policy {
+    check    = "duplicate_rule_name"
+    severity = "warning"
+  }
+  policy {
+    check    = "origin_sure_route"
+    severity = "off"
+  }
+}
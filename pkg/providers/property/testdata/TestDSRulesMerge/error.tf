@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_merge" "test" {
+  rules = [
+    file("testdata/TestDSRulesMerge/base.json"),
+    file("testdata/TestDSRulesMerge/product.json"),
+  ]
+  strategy = "error"
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_include_graph" "graph" {
+  contract_id = "ctr_1"
+  group_id    = "grp_1"
+}
@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id                    = "test"
+  contact                        = ["user@example.com"]
+  version                        = 1
+  auto_acknowledge_rule_warnings = true
+  note                           = "property activation note for creating"
+  window {
+    days     = ["SATURDAY", "SOMEDAY"]
+    start    = "25:00"
+    duration = "48h"
+  }
+}
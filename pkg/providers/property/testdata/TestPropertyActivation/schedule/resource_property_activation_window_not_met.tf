@@ -0,0 +1,15 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id                    = "test"
+  contact                        = ["user@example.com"]
+  version                        = 1
+  auto_acknowledge_rule_warnings = true
+  note                           = "property activation note for creating"
+  not_before                     = "2999-01-01T00:00:00Z"
+  timeouts {
+    default = "1h"
+  }
+}
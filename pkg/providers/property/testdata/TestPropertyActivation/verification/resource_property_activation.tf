@@ -0,0 +1,19 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id                    = "test"
+  contact                        = ["user@example.com"]
+  version                        = 1
+  auto_acknowledge_rule_warnings = true
+  note                           = "property activation note for creating"
+
+  verification {
+    attempts = 1
+    check {
+      # nothing listens on this port, so the check always fails
+      url = "http://127.0.0.1:1/"
+    }
+  }
+}
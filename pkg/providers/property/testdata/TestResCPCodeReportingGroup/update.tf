@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_cp_code_reporting_group" "finance" {
+  name        = "finance and billing"
+  contract_id = "ctr_C-1"
+  group_id    = "grp_12"
+  cp_code_ids = ["cpc_123", "cpc_789"]
+}
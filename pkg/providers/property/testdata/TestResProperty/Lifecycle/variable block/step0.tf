@@ -0,0 +1,30 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property" "test" {
+  name        = "test_property"
+  contract_id = "ctr_1"
+  group_id    = "grp_2"
+  product_id  = "prd_3"
+  rules = jsonencode(
+    {
+      "rules" : {
+        "name" : "default",
+        "options" : {}
+      }
+    }
+  )
+
+  variable {
+    name  = "PMUSER_ORIGIN"
+    value = "origin-a.example.com"
+  }
+
+  hostnames {
+    cert_provisioning_type = "DEFAULT"
+    cname_from             = "from.test.domain"
+    cname_to               = "to.test.domain"
+    cname_type             = "EDGE_HOSTNAME"
+  }
+}
@@ -0,0 +1,18 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation_batch" "batch" {
+  contact = ["user@example.com"]
+  note    = "batch note"
+
+  activation {
+    property_id = "prp_a"
+    version     = 2
+  }
+
+  activation {
+    property_id = "b"
+    version     = 3
+  }
+}
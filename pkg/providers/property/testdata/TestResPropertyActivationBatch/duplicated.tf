@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation_batch" "batch" {
+  contact = ["user@example.com"]
+
+  activation {
+    property_id = "prp_a"
+    version     = 2
+  }
+
+  activation {
+    property_id = "a"
+    version     = 3
+  }
+}
@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_hostname_bucket" "bucket" {
+  property_id = "1"
+  contract_id = "ctr_1"
+  group_id    = "grp_1"
+  note        = "bucket note"
+
+  hostnames {
+    cname_from             = "b.example.com"
+    cname_to               = "b.example.com.edgekey.net"
+    cert_provisioning_type = "CPS_MANAGED"
+  }
+}
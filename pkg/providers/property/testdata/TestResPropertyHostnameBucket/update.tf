@@ -0,0 +1,22 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_hostname_bucket" "bucket" {
+  property_id = "1"
+  contract_id = "ctr_1"
+  group_id    = "grp_1"
+  note        = "bucket note"
+
+  hostnames {
+    cname_from             = "a.example.com"
+    cname_to               = "a.example.com.edgekey.net"
+    cert_provisioning_type = "DEFAULT"
+  }
+
+  hostnames {
+    cname_from             = "c.example.com"
+    cname_to               = "c.example.com.edgekey.net"
+    cert_provisioning_type = "CPS_MANAGED"
+  }
+}
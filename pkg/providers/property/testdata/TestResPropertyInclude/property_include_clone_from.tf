@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_include" "test" {
+  contract_id = "ctr_123"
+  group_id    = "grp_123"
+  product_id  = "prd_test"
+  name        = "test_include"
+  type        = "MICROSERVICES"
+  rule_format = "v2022-06-28"
+
+  clone_from {
+    include_id = "inc_987"
+    version    = 3
+  }
+}